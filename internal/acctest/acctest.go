@@ -42,11 +42,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/outposts"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -113,6 +115,15 @@ var (
 	ProtoV5ProviderFactories map[string]func() (tfprotov5.ProviderServer, error) = protoV5ProviderFactoriesInit(context.Background(), ProviderName)
 )
 
+// ProtoV6EchoProviderFactories is a static map containing only the echo provider instance.
+//
+// The echo provider is used in tests to expose the values of ephemeral resources via a managed resource's state.
+var (
+	ProtoV6EchoProviderFactories map[string]func() (tfprotov6.ProviderServer, error) = map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+)
+
 // Provider is the "main" provider instance
 //
 // This Provider can be used in testing code for API calls without requiring
@@ -138,12 +149,14 @@ func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 
 	for _, name := range providerNames {
 		factories[name] = func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 			if err != nil {
 				return nil, err
 			}
 
+			configureEmulator(primary)
+
 			return providerServerFactory(), nil
 		}
 	}
@@ -163,6 +176,8 @@ func protoV5ProviderFactoriesNamedInit(ctx context.Context, t *testing.T, provid
 			t.Fatal(err)
 		}
 
+		configureEmulator(p)
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -185,6 +200,8 @@ func protoV5ProviderFactoriesPlusProvidersInit(ctx context.Context, t *testing.T
 			t.Fatal(err)
 		}
 
+		configureEmulator(p)
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -275,10 +292,13 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
-		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		// Emulators generally accept any credentials.
+		if !isEmulatorEnabled() {
+			envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
-		if os.Getenv(envvar.AccessKeyId) != "" {
-			envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			if os.Getenv(envvar.AccessKeyId) != "" {
+				envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			}
 		}
 
 		// Setting the AWS_DEFAULT_REGION environment variable here allows all tests to omit
@@ -291,7 +311,12 @@ func PreCheck(ctx context.Context, t *testing.T) {
 		region := Region()
 		os.Setenv(envvar.DefaultRegion, region)
 
-		diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(nil))
+		var raw map[string]any
+		if isEmulatorEnabled() {
+			raw = emulatorProviderConfig()
+		}
+
+		diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(raw))
 		if err := sdkdiag.DiagnosticsError(diags); err != nil {
 			t.Fatalf("configuring provider: %s", err)
 		}
@@ -1630,9 +1655,15 @@ func RegisterServiceErrorCheckFunc(serviceID string, f ServiceErrorCheckFunc) {
 	serviceErrorCheckFuncs[serviceID] = f
 }
 
+// ErrorCheck returns an ErrorCheckFunc that skips tests for errors indicating that the specified services
+// or one of their features is not supported.
+// When running against a local AWS API emulator, the test is skipped immediately if the emulator
+// does not support all the specified services.
 func ErrorCheck(t *testing.T, serviceIDs ...string) resource.ErrorCheckFunc {
 	t.Helper()
 
+	PreCheckEmulatorHasService(t, serviceIDs...)
+
 	return func(err error) error {
 		if err == nil {
 			return nil
@@ -1809,6 +1840,18 @@ data "aws_service" "provider_test" {
 `
 
 // ConfigCompose can be called to concatenate multiple strings to build test configurations
+// ConfigEchoEphemeralResource returns configuration that exposes the value of the specified ephemeral resource
+// via the `data` attribute of the `echo.test` managed resource.
+func ConfigEchoEphemeralResource(address string) string {
+	return fmt.Sprintf(`
+provider "echo" {
+  data = %[1]s
+}
+
+resource "echo" "test" {}
+`, address)
+}
+
 func ConfigCompose(config ...string) string {
 	var str strings.Builder
 
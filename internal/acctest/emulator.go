@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Static credentials used when running against an emulator if no other credentials are configured.
+	// Emulators generally accept any credentials.
+	emulatorAccessKey = "test"
+	emulatorSecretKey = "test"
+)
+
+// isEmulatorEnabled returns whether acceptance tests are run against a local AWS API emulator.
+func isEmulatorEnabled() bool {
+	return os.Getenv(envvar.AccEmulatorEndpoint) != ""
+}
+
+// emulatorProviderConfig returns provider configuration arguments that target the local AWS API emulator.
+// Every service endpoint is set to the emulator's base URL, S3 uses path-style addressing
+// and credentials, region and EC2 metadata API validation are skipped.
+func emulatorProviderConfig() map[string]any {
+	endpoint := os.Getenv(envvar.AccEmulatorEndpoint)
+
+	endpoints := make(map[string]any)
+	for _, v := range names.Endpoints() {
+		endpoints[v.ProviderPackage] = endpoint
+	}
+
+	config := map[string]any{
+		"endpoints":                   []any{endpoints},
+		"s3_use_path_style":           true,
+		"skip_credentials_validation": true,
+		"skip_metadata_api_check":     "true",
+		"skip_region_validation":      true,
+	}
+
+	if _, _, err := envvar.RequireOneOf([]string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, ""); err != nil {
+		config["access_key"] = emulatorAccessKey
+		config["secret_key"] = emulatorSecretKey
+	}
+
+	return config
+}
+
+// emulatorProviderConfigureContextFunc returns a provider configuration function that overrides the
+// configured arguments with those that target the local AWS API emulator.
+func emulatorProviderConfigureContextFunc(configureContextFunc schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		for k, v := range emulatorProviderConfig() {
+			if err := d.Set(k, v); err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "setting %s for emulator: %s", k, err)
+			}
+		}
+
+		return configureContextFunc(ctx, d)
+	}
+}
+
+// configureEmulator configures the provider to target the local AWS API emulator if enabled.
+func configureEmulator(p *schema.Provider) {
+	if isEmulatorEnabled() {
+		p.ConfigureContextFunc = emulatorProviderConfigureContextFunc(p.ConfigureContextFunc)
+	}
+}
+
+// emulatorUnsupportedServices returns those of the specified service IDs that are not in
+// the comma-separated list of supported service IDs.
+// An empty list indicates that all services are supported.
+func emulatorUnsupportedServices(supported string, serviceIDs []string) []string {
+	if strings.TrimSpace(supported) == "" {
+		return nil
+	}
+
+	var unsupported []string
+
+	for _, serviceID := range serviceIDs {
+		found := false
+
+		for _, v := range strings.Split(supported, ",") {
+			if strings.EqualFold(strings.TrimSpace(v), serviceID) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			unsupported = append(unsupported, serviceID)
+		}
+	}
+
+	return unsupported
+}
+
+// PreCheckEmulatorHasService skips a test if it is run against a local AWS API emulator
+// that does not support all the specified services.
+//
+// Services passed to ErrorCheck are checked automatically. Use this function for any other
+// services a test depends on, for example IAM for a test that creates an execution role.
+func PreCheckEmulatorHasService(t *testing.T, serviceIDs ...string) {
+	t.Helper()
+
+	if !isEmulatorEnabled() {
+		return
+	}
+
+	if unsupported := emulatorUnsupportedServices(os.Getenv(envvar.AccEmulatorServices), serviceIDs); len(unsupported) > 0 {
+		t.Skipf("skipping tests; emulator does not support %s (%s)", strings.Join(unsupported, ", "), envvar.AccEmulatorServices)
+	}
+}
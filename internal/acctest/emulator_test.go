@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestEmulatorUnsupportedServices(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		supported  string
+		serviceIDs []string
+		expected   []string
+	}{
+		"all supported by default": {
+			serviceIDs: []string{names.EC2ServiceID, names.S3ServiceID},
+		},
+		"no services": {
+			supported: "S3",
+		},
+		"supported": {
+			supported:  "DynamoDB,S3,SQS",
+			serviceIDs: []string{names.S3ServiceID, names.SQSServiceID},
+		},
+		"case and whitespace insensitive": {
+			supported:  " dynamodb , s3 ",
+			serviceIDs: []string{names.DynamoDBServiceID, names.S3ServiceID},
+		},
+		"service ID containing spaces": {
+			supported:  "API Gateway,Lambda",
+			serviceIDs: []string{names.APIGatewayServiceID},
+		},
+		"unsupported": {
+			supported:  "S3",
+			serviceIDs: []string{names.EC2ServiceID, names.S3ServiceID, names.IAMServiceID},
+			expected:   []string{names.EC2ServiceID, names.IAMServiceID},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := acctest.EmulatorUnsupportedServices(testCase.supported, testCase.serviceIDs)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -5,5 +5,6 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder            = closeVCRRecorder
+	EmulatorUnsupportedServices = emulatorUnsupportedServices
 )
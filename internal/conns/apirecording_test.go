@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+func TestAPIRecordingRecordReplay(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := t.TempDir()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.Write([]byte(`{"Echo":` + string(body) + `}`)) //nolint:errcheck // test server
+	}))
+
+	do := func(t *testing.T, c *APIRecordingConfig, url, body string) string {
+		t.Helper()
+
+		r, err := c.newRecorder(ctx, http.DefaultTransport)
+		if err != nil {
+			t.Fatalf("creating recorder: %s", err)
+		}
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=secret")
+		request.Header.Set("Content-Type", "application/x-amz-json-1.1")
+
+		response, err := (&http.Client{Transport: r}).Do(request)
+		if err != nil {
+			t.Fatalf("sending request: %s", err)
+		}
+		defer response.Body.Close()
+
+		got, err := io.ReadAll(response.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(got)
+	}
+
+	record := &APIRecordingConfig{Mode: APIRecordingModeRecord, Path: path}
+	if got, want := do(t, record, server.URL, `{"A":1,"ClientToken":"one"}`), `{"Echo":{"A":1,"ClientToken":"one"}}`; got != want {
+		t.Errorf("episode 0 recorded response = %q, want %q", got, want)
+	}
+	if got, want := do(t, record, server.URL, `{"A":2}`), `{"Echo":{"A":2}}`; got != want {
+		t.Errorf("episode 1 recorded response = %q, want %q", got, want)
+	}
+
+	data, err := os.ReadFile(filepath.Join(path, "aws-000.yaml"))
+	if err != nil {
+		t.Fatalf("reading cassette: %s", err)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Error("cassette contains Authorization header")
+	}
+
+	server.Close()
+
+	// Requests must now be served from the cassettes, in episode order, ignoring idempotency tokens.
+	replay := &APIRecordingConfig{Mode: APIRecordingModeReplay, Path: path}
+	for _, tc := range []struct {
+		body string
+		want string
+	}{
+		{`{"ClientToken":"two","A":1}`, `{"Echo":{"A":1,"ClientToken":"one"}}`},
+		{`{"A":2}`, `{"Echo":{"A":2}}`},
+		{`{"ClientToken":"three","A":1}`, `{"Echo":{"A":1,"ClientToken":"one"}}`},
+	} {
+		if got := do(t, replay, server.URL, tc.body); got != tc.want {
+			t.Errorf("replayed response = %q, want %q", got, tc.want)
+		}
+	}
+}
+
+func TestAPIRecordingReplayMissingCassette(t *testing.T) {
+	t.Parallel()
+
+	c := &APIRecordingConfig{Mode: APIRecordingModeReplay, Path: t.TempDir()}
+	if _, err := c.newRecorder(context.Background(), http.DefaultTransport); err == nil {
+		t.Error("expected error, got none")
+	}
+}
+
+func TestAPIRecordingRedaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := t.TempDir()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("X-Amz-Target") {
+		case "secretsmanager.GetSecretValue":
+			w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+			w.Write([]byte(`{"Name":"example","SecretString":"s3cr3t-string"}`)) //nolint:errcheck // test server
+		case "secretsmanager.PutSecretValue":
+			w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+			w.Write([]byte(`{"Name":"example"}`)) //nolint:errcheck // test server
+		default:
+			w.Header().Set("Content-Type", "text/xml")
+			w.Write([]byte(`<AssumeRoleResponse><AssumeRoleResult><Credentials><AccessKeyId>AKIAEXAMPLE</AccessKeyId><SecretAccessKey>s3cr3t-key</SecretAccessKey><SessionToken>s3cr3t-token</SessionToken></Credentials></AssumeRoleResult></AssumeRoleResponse>`)) //nolint:errcheck // test server
+		}
+	}))
+	defer server.Close()
+
+	type call struct {
+		target      string
+		contentType string
+		body        string
+	}
+	calls := []call{
+		{"secretsmanager.GetSecretValue", "application/x-amz-json-1.1", `{"SecretId":"example"}`},
+		{"secretsmanager.PutSecretValue", "application/x-amz-json-1.1", `{"SecretId":"example","SecretString":"s3cr3t-put"}`},
+		{"", "application/x-www-form-urlencoded; charset=utf-8", `Action=AssumeRole&RoleArn=arn%3Aaws%3Aiam%3A%3A123456789012%3Arole%2Fexample&Version=2011-06-15`},
+	}
+
+	do := func(t *testing.T, c *APIRecordingConfig) []string {
+		t.Helper()
+
+		r, err := c.newRecorder(ctx, http.DefaultTransport)
+		if err != nil {
+			t.Fatalf("creating recorder: %s", err)
+		}
+
+		var bodies []string
+		for _, call := range calls {
+			request, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(call.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			request.Header.Set("Content-Type", call.contentType)
+			if call.target != "" {
+				request.Header.Set("X-Amz-Target", call.target)
+			}
+
+			response, err := (&http.Client{Transport: r}).Do(request)
+			if err != nil {
+				t.Fatalf("sending request: %s", err)
+			}
+
+			got, err := io.ReadAll(response.Body)
+			response.Body.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			bodies = append(bodies, string(got))
+		}
+
+		return bodies
+	}
+
+	// Responses returned while recording are not redacted.
+	recorded := do(t, &APIRecordingConfig{Mode: APIRecordingModeRecord, Path: path})
+	if !strings.Contains(recorded[0], "s3cr3t-string") || !strings.Contains(recorded[2], "s3cr3t-key") {
+		t.Errorf("recorded responses = %q, want unredacted secrets", recorded)
+	}
+
+	cst, err := cassette.Load(filepath.Join(path, "aws-000"))
+	if err != nil {
+		t.Fatalf("loading cassette: %s", err)
+	}
+	if got, want := len(cst.Interactions), len(calls); got != want {
+		t.Fatalf("cassette has %d interactions, want %d", got, want)
+	}
+	for i, v := range cst.Interactions {
+		if v.ID != i {
+			t.Errorf("interaction %d has ID %d", i, v.ID)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(path, "aws-000.yaml"))
+	if err != nil {
+		t.Fatalf("reading cassette: %s", err)
+	}
+	if strings.Contains(string(data), "s3cr3t") {
+		t.Errorf("cassette contains secret values:\n%s", data)
+	}
+
+	// Requests containing secret values still match when replaying.
+	replayed := do(t, &APIRecordingConfig{Mode: APIRecordingModeReplay, Path: path})
+	for i, want := range []string{`"SecretString":"REDACTED"`, `"Name":"example"`, `<SecretAccessKey>REDACTED</SecretAccessKey>`} {
+		if !strings.Contains(replayed[i], want) {
+			t.Errorf("replayed response %d = %q, want %q", i, replayed[i], want)
+		}
+	}
+}
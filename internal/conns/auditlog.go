@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// auditRecord is a single line in the audit log.
+type auditRecord struct {
+	Time         time.Time `json:"time"`
+	Service      string    `json:"service"`
+	Operation    string    `json:"operation"`
+	Region       string    `json:"region,omitempty"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	ResourceName string    `json:"resource_name,omitempty"`
+	ResourceID   string    `json:"resource_id,omitempty"`
+	RequestID    string    `json:"request_id,omitempty"`
+	DurationMS   int64     `json:"duration_ms"`
+	ErrorCode    string    `json:"error_code,omitempty"`
+}
+
+// auditLogger appends a JSON Lines record to a file for each AWS API call that is not a read.
+type auditLogger struct {
+	file *os.File
+	lock sync.Mutex
+}
+
+func newAuditLogger(path string) (*auditLogger, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log (%s): %w", path, err)
+	}
+
+	return &auditLogger{
+		file: file,
+	}, nil
+}
+
+func (l *auditLogger) close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.file.Close()
+}
+
+// Prefixes of AWS API operation names that don't modify resources.
+var readOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+}
+
+func isReadOperation(operation string) bool {
+	for _, prefix := range readOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (l *auditLogger) log(ctx context.Context, record auditRecord) {
+	if v, ok := FromContext(ctx); ok {
+		record.ResourceType = v.TypeName
+		record.ResourceName = v.ResourceName
+		record.ResourceID = v.ResourceID
+	}
+
+	data, err := json.Marshal(record)
+	if err == nil {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+
+		_, err = l.file.Write(append(data, '\n'))
+	}
+
+	if err != nil {
+		tflog.Warn(ctx, "Writing audit log record", map[string]any{
+			"error": err,
+		})
+	}
+}
+
+// sdkv2APIOption returns an AWS SDK for Go v2 API option that audits calls for the specified service package.
+func (l *auditLogger) sdkv2APIOption(servicePackageName string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Add before the Retry middleware so that each call, rather than each attempt, is audited.
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TFAuditLog", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			operation := awsmiddleware.GetOperationName(ctx)
+
+			if isReadOperation(operation) {
+				return next.HandleInitialize(ctx, in)
+			}
+
+			start := time.Now()
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			record := auditRecord{
+				Time:       start.UTC(),
+				Service:    servicePackageName,
+				Operation:  operation,
+				Region:     awsmiddleware.GetRegion(ctx),
+				DurationMS: time.Since(start).Milliseconds(),
+			}
+			if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+				record.RequestID = v
+			}
+			if err != nil {
+				var apiErr smithy.APIError
+				if errors.As(err, &apiErr) {
+					record.ErrorCode = apiErr.ErrorCode()
+				} else {
+					record.ErrorCode = "ClientError"
+				}
+			}
+
+			l.log(ctx, record)
+
+			return out, metadata, err
+		}), middleware.After)
+	}
+}
+
+// sdkv1Handlers adds AWS SDK for Go v1 handlers that audit calls for the specified service package.
+func (l *auditLogger) sdkv1Handlers(servicePackageName string) func(*request.Handlers) {
+	return func(handlers *request.Handlers) {
+		// Complete handlers run once per call, after all retries.
+		handlers.Complete.PushBackNamed(request.NamedHandler{
+			Name: "TFAuditLog",
+			Fn: func(r *request.Request) {
+				if isReadOperation(r.Operation.Name) {
+					return
+				}
+
+				record := auditRecord{
+					Time:       r.Time.UTC(),
+					Service:    servicePackageName,
+					Operation:  r.Operation.Name,
+					RequestID:  r.RequestID,
+					DurationMS: time.Since(r.Time).Milliseconds(),
+				}
+				if r.Config.Region != nil {
+					record.Region = *r.Config.Region
+				}
+				if r.Error != nil {
+					var awsErr awserr.Error
+					if errors.As(r.Error, &awsErr) {
+						record.ErrorCode = awsErr.Code()
+					} else {
+						record.ErrorCode = "ClientError"
+					}
+				}
+
+				l.log(r.Context(), record)
+			},
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIsReadOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"CreateVpc":           false,
+		"DeleteBucket":        false,
+		"DescribeInstances":   true,
+		"GetObject":           true,
+		"GenerateDataKey":     false,
+		"ListTagsForResource": true,
+		"PutBucketPolicy":     false,
+		"TagResource":         false,
+	}
+
+	for operation, want := range testCases {
+		if got := isReadOperation(operation); got != want {
+			t.Errorf("isReadOperation(%q) = %t, want %t", operation, got, want)
+		}
+	}
+}
+
+func TestAuditLoggerLog(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	l, err := newAuditLogger(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := NewResourceContext(context.Background(), "ec2", "VPC", "aws_vpc")
+	if v, ok := FromContext(ctx); ok {
+		v.ResourceID = "vpc-12345678"
+	}
+	l.log(ctx, auditRecord{
+		Time:       time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Service:    "ec2",
+		Operation:  "CreateVpc",
+		Region:     "us-west-2", //lintignore:AWSAT003
+		RequestID:  "1234",
+		DurationMS: 42,
+	})
+	l.log(context.Background(), auditRecord{
+		Time:      time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC),
+		Service:   "iam",
+		Operation: "CreateRole",
+		ErrorCode: "EntityAlreadyExists",
+	})
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if got, want := len(lines), 2; got != want {
+		t.Fatalf("got %d records, want %d", got, want)
+	}
+
+	var got auditRecord
+	if err := json.Unmarshal([]byte(lines[0]), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.ResourceType != "aws_vpc" || got.ResourceName != "VPC" || got.ResourceID != "vpc-12345678" || got.Operation != "CreateVpc" || got.RequestID != "1234" || got.DurationMS != 42 {
+		t.Errorf("unexpected record: %s", lines[0])
+	}
+
+	if want := `{"time":"2024-01-02T03:04:06Z","service":"iam","operation":"CreateRole","duration_ms":0,"error_code":"EntityAlreadyExists"}`; lines[1] != want {
+		t.Errorf("record = %s, want %s", lines[1], want)
+	}
+
+	if err := l.close(); err != nil {
+		t.Fatal(err)
+	}
+}
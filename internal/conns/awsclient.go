@@ -9,20 +9,26 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
+	"github.com/YakDriver/regexache"
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	directoryservice_sdkv1 "github.com/aws/aws-sdk-go/service/directoryservice"
 	efs_sdkv1 "github.com/aws/aws-sdk-go/service/efs"
 	opsworks_sdkv1 "github.com/aws/aws-sdk-go/service/opsworks"
 	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/smithy-go/middleware"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
+	basevalidation "github.com/hashicorp/aws-sdk-go-base/v2/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -35,20 +41,30 @@ type AWSClient struct {
 	Partition         string
 	Region            string
 	ServicePackages   map[string]ServicePackage
-
-	awsConfig                 *aws_sdkv2.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	dnsSuffix                 string
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	TagPolicyConfig   *tftags.PolicyConfig
+
+	allowedRegions                 []string // From provider configuration.
+	allowedStatefulResourceChanges []string // From provider configuration.
+	awsConfig                      *aws_sdkv2.Config
+	clients                        map[string]any
+	conns                          map[string]any
+	dnsSuffix                      string
+	endpoints                      map[string]string // From provider configuration.
+	httpClient                     *http.Client
+	auditLogger                    *auditLogger
+	lock                           sync.Mutex
+	logger                         baselogging.Logger
+	protectStatefulResources       bool                           // From provider configuration.
+	rateLimiters                   map[string]*serviceRateLimiter // Keyed on service package name.
+	readOnly                       bool                           // From provider configuration.
+	regionalClients                map[string]*AWSClient          // Keyed on AWS Region.
+	retryConfig                    *RetryConfig                   // From provider configuration.
+	session                        *session_sdkv1.Session
+	s3ExpressClient                *s3_sdkv2.Client
+	s3UsePathStyle                 bool   // From provider configuration.
+	s3USEast1RegionalEndpoint      string // From provider configuration.
+	skipRegionValidation           bool   // From provider configuration.
+	stsRegion                      string // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -83,6 +99,84 @@ func (c *AWSClient) EFSConnForRegion(ctx context.Context, region string) *efs_sd
 	return efs_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
 }
 
+// ForRegion returns an AWSClient for the specified AWS Region.
+// If the specified region is empty or is the default the receiver is returned.
+// Otherwise an AWSClient sharing the receiver's credentials and configuration is returned.
+// The returned AWSClient's API clients are created on demand and use any configured endpoint override.
+func (c *AWSClient) ForRegion(ctx context.Context, region string) (*AWSClient, error) {
+	if region == "" || region == c.Region {
+		return c, nil
+	}
+
+	if err := c.ValidateRegion(ctx, region); err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if client, ok := c.regionalClients[region]; ok {
+		return client, nil
+	}
+
+	dnsSuffix := c.dnsSuffix
+	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), region); ok {
+		dnsSuffix = p.DNSSuffix()
+	}
+
+	awsConfig := c.awsConfig.Copy()
+	awsConfig.Region = region
+
+	client := &AWSClient{
+		AccountID:         c.AccountID,
+		DefaultTagsConfig: c.DefaultTagsConfig,
+		IgnoreTagsConfig:  c.IgnoreTagsConfig,
+		Partition:         c.Partition,
+		Region:            region,
+		ServicePackages:   c.ServicePackages,
+		TagPolicyConfig:   c.TagPolicyConfig,
+
+		allowedRegions:                 c.allowedRegions,
+		allowedStatefulResourceChanges: c.allowedStatefulResourceChanges,
+		awsConfig:                      &awsConfig,
+		clients:                        make(map[string]any, 0),
+		conns:                          make(map[string]any, 0),
+		dnsSuffix:                      dnsSuffix,
+		endpoints:                      c.endpoints,
+		auditLogger:                    c.auditLogger,
+		httpClient:                     c.httpClient,
+		logger:                         c.logger,
+		protectStatefulResources:       c.protectStatefulResources,
+		rateLimiters:                   c.rateLimiters,
+		readOnly:                       c.readOnly,
+		retryConfig:                    c.retryConfig,
+		session:                        c.session.Copy(aws_sdkv1.NewConfig().WithRegion(region)),
+		s3UsePathStyle:                 c.s3UsePathStyle,
+		s3USEast1RegionalEndpoint:      c.s3USEast1RegionalEndpoint,
+		skipRegionValidation:           c.skipRegionValidation,
+		stsRegion:                      c.stsRegion,
+	}
+
+	if c.regionalClients == nil {
+		c.regionalClients = make(map[string]*AWSClient)
+	}
+	c.regionalClients[region] = client
+
+	return client, nil
+}
+
+var importIDWithRegionRegexp = regexache.MustCompile(`^(.+)@([a-z]{2}(?:-[a-z]+)+-\d{1,2})$`)
+
+// ParseImportIDWithRegion parses an import ID of the form "<id>@<region>".
+// If the import ID is not of that form the import ID is returned unchanged.
+func ParseImportIDWithRegion(id string) (string, string, bool) {
+	if m := importIDWithRegionRegexp.FindStringSubmatch(id); len(m) > 0 {
+		return m[1], m[2], true
+	}
+
+	return id, "", false
+}
+
 // OpsWorksConnForRegion returns an AWS SDK For Go v1 OpsWorks API client for the specified AWS Region.
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
@@ -139,6 +233,48 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
 	return c.s3ExpressClient
 }
 
+// ValidateRegion returns an error if the specified AWS Region cannot be used with this AWSClient.
+// The Region must be in the configured AWS partition, one of any allowed Regions and, unless Region validation is skipped, a supported Region.
+func (c *AWSClient) ValidateRegion(_ context.Context, region string) error {
+	if err := verifyRegionAllowed(c.allowedRegions, region); err != nil {
+		return err
+	}
+
+	if !c.skipRegionValidation {
+		if err := basevalidation.SupportedRegion(region); err != nil {
+			return err
+		}
+	}
+
+	if partition := names.PartitionForRegion(region); c.Partition != "" && partition != c.Partition {
+		return fmt.Errorf("Region (%s) is not in the configured AWS partition (%s)", region, c.Partition)
+	}
+
+	return nil
+}
+
+// Close releases any resources, such as open files, held by the client.
+func (c *AWSClient) Close() error {
+	if l := c.auditLogger; l != nil {
+		return l.close()
+	}
+
+	return nil
+}
+
+// ReadOnly returns the read_only provider configuration value.
+// If true, resources must not be created, updated or deleted.
+func (c *AWSClient) ReadOnly(context.Context) bool {
+	return c.readOnly
+}
+
+// StatefulResourceProtected returns whether replacement or deletion of the specified stateful resource type is prevented.
+// Stateful resources are protected if the protect_stateful_resources provider configuration value is true,
+// unless the resource type is one of the allowed_stateful_resource_changes.
+func (c *AWSClient) StatefulResourceProtected(_ context.Context, typeName string) bool {
+	return c.protectStatefulResources && !slices.Contains(c.allowedStatefulResourceChanges, typeName)
+}
+
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
 func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
@@ -284,6 +420,40 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		m["sts_region"] = c.stsRegion
 	}
 
+	// Customizations from provider configuration apply to every API client for the service package.
+	var apiOptions []func(*middleware.Stack) error
+	var handlers []func(*request_sdkv1.Handlers)
+	if l, ok := c.rateLimiters[servicePackageName]; ok {
+		apiOptions = append(apiOptions, l.sdkv2APIOption())
+		handlers = append(handlers, l.sdkv1Handlers)
+	}
+	if l := c.auditLogger; l != nil {
+		apiOptions = append(apiOptions, l.sdkv2APIOption(servicePackageName))
+		handlers = append(handlers, l.sdkv1Handlers(servicePackageName))
+	}
+	var retryer func() aws_sdkv2.Retryer
+	if v := c.retryConfig; v != nil {
+		r := newServiceRetryer(servicePackageName, v)
+		apiOptions = append(apiOptions, r.sdkv2APIOption())
+		handlers = append(handlers, r.sdkv1Handlers)
+		retryer = r.sdkv2Retryer(c.awsConfig.Retryer)
+	}
+	if len(apiOptions) > 0 {
+		cfg := c.awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), apiOptions...)
+		if retryer != nil {
+			// Service packages that customize retries wrap this Retryer.
+			cfg.Retryer = retryer
+		}
+		m["aws_sdkv2_config"] = &cfg
+
+		session := c.session.Copy()
+		for _, f := range handlers {
+			f(&session.Handlers)
+		}
+		m["session"] = session
+	}
+
 	return m
 }
 
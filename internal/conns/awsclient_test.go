@@ -6,6 +6,11 @@ package conns
 import (
 	"context"
 	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -181,3 +186,110 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestParseImportIDWithRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		ImportID       string
+		ExpectedID     string
+		ExpectedRegion string
+		ExpectedOK     bool
+	}{
+		{
+			Name:       "no Region",
+			ImportID:   "vpc-a01106c2",
+			ExpectedID: "vpc-a01106c2",
+		},
+		{
+			Name:           "Region",
+			ImportID:       "vpc-a01106c2@eu-west-1", //lintignore:AWSAT003
+			ExpectedID:     "vpc-a01106c2",
+			ExpectedRegion: "eu-west-1", //lintignore:AWSAT003
+			ExpectedOK:     true,
+		},
+		{
+			Name:           "GovCloud Region",
+			ImportID:       "vpc-a01106c2@us-gov-west-1", //lintignore:AWSAT003
+			ExpectedID:     "vpc-a01106c2",
+			ExpectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+			ExpectedOK:     true,
+		},
+		{
+			Name:       "not a Region",
+			ImportID:   "user@example.com",
+			ExpectedID: "user@example.com",
+		},
+		{
+			Name:       "empty ID",
+			ImportID:   "@eu-west-1", //lintignore:AWSAT003
+			ExpectedID: "@eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			id, region, ok := ParseImportIDWithRegion(testCase.ImportID)
+
+			if id != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", id, testCase.ExpectedID)
+			}
+			if region != testCase.ExpectedRegion {
+				t.Errorf("got Region %s, expected %s", region, testCase.ExpectedRegion)
+			}
+			if ok != testCase.ExpectedOK {
+				t.Errorf("got %t, expected %t", ok, testCase.ExpectedOK)
+			}
+		})
+	}
+}
+
+func TestAWSClientForRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	c := &AWSClient{
+		Region:    "us-west-2",                            //lintignore:AWSAT003
+		awsConfig: &aws_sdkv2.Config{Region: "us-west-2"}, //lintignore:AWSAT003
+		endpoints: map[string]string{
+			names.SQS: "http://localhost:4566",
+		},
+		session:              &session_sdkv1.Session{Config: aws_sdkv1.NewConfig()},
+		skipRegionValidation: true,
+	}
+
+	got, err := c.ForRegion(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != c {
+		t.Errorf("ForRegion(\"\") didn't return the receiver")
+	}
+
+	regional, err := c.ForRegion(ctx, "eu-west-1") //lintignore:AWSAT003
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := regional.Region, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region = %s, want %s", got, want)
+	}
+	if got, want := regional.awsConfig.Region, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("awsConfig.Region = %s, want %s", got, want)
+	}
+	if got, want := regional.resolveEndpoint(ctx, names.SQS), "http://localhost:4566"; got != want {
+		t.Errorf("resolveEndpoint(%s) = %s, want %s", names.SQS, got, want)
+	}
+
+	again, err := c.ForRegion(ctx, "eu-west-1") //lintignore:AWSAT003
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != regional {
+		t.Errorf("ForRegion didn't return the cached client")
+	}
+}
@@ -11,7 +11,10 @@ import (
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	client_sdkv1 "github.com/aws/aws-sdk-go/aws/client"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
@@ -29,8 +32,14 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	AllowedOrganizationIDs         []string
+	AllowedOrganizationPaths       []string
+	AllowedRegions                 []string
+	AllowedStatefulResourceChanges []string
+	APIRecording                   *APIRecordingConfig
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogFile                   string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
@@ -45,11 +54,15 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	ProtectStatefulResources       bool
+	ReadOnly                       bool
 	Region                         string
+	Retry                          *RetryConfig
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceRateLimits              map[string]ServiceRateLimit
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -57,6 +70,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -71,8 +85,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	ctx, logger := logging.NewTfLogger(ctx)
 
 	const (
-		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
+		defaultMaxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
+	maxBackoff := defaultMaxBackoff
+	if c.Retry != nil && c.Retry.MaxBackoff > 0 {
+		maxBackoff = c.Retry.MaxBackoff
+	}
 	awsbaseConfig := awsbase.Config{
 		AccessKey:         c.AccessKey,
 		AllowedAccountIds: c.AllowedAccountIds,
@@ -139,6 +157,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	if c.APIRecording != nil {
+		if err := c.APIRecording.configure(ctx, &awsbaseConfig); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring API recording: %s", err)
+		}
+	}
+
 	// Avoid duplicate calls to STS by enabling SkipCredsValidation for the call to GetAwsConfig
 	// and then restoring the configured value for the call to GetAwsAccountIDAndPartition.
 	skipCredsValidation := awsbaseConfig.SkipCredsValidation
@@ -166,6 +190,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if err := verifyRegionAllowed(c.AllowedRegions, c.Region); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -183,6 +211,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if maxBackoff != defaultMaxBackoff {
+		maxRetries := client_sdkv1.DefaultRetryerMaxNumRetries
+		if v := session.Config.MaxRetries; v != nil {
+			maxRetries = aws_sdkv1.IntValue(v)
+		}
+		session = session.Copy(request_sdkv1.WithRetryer(aws_sdkv1.NewConfig(), client_sdkv1.DefaultRetryer{
+			NumMaxRetries:    maxRetries,
+			MaxRetryDelay:    maxBackoff,
+			MaxThrottleDelay: maxBackoff,
+		}))
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -204,6 +244,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, sdkdiag.AppendErrorf(diags, err.Error())
 	}
 
+	if err := c.verifyOrganizationAllowed(ctx, cfg, accountID); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
 	dnsSuffix := "amazonaws.com"
 	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), c.Region); ok {
 		dnsSuffix = p.DNSSuffix()
@@ -215,19 +259,44 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.TagPolicyConfig = c.TagPolicyConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
 	// Used for lazy-loading AWS API clients.
+	client.allowedRegions = c.AllowedRegions
+	client.allowedStatefulResourceChanges = c.AllowedStatefulResourceChanges
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.protectStatefulResources = c.ProtectStatefulResources
+	client.readOnly = c.ReadOnly
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.skipRegionValidation = c.SkipRegionValidation
 	client.stsRegion = c.STSRegion
 
+	if c.AuditLogFile != "" {
+		auditLogger, err := newAuditLogger(c.AuditLogFile)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		client.auditLogger = auditLogger
+	}
+
+	if v := c.Retry; v != nil && (v.Budget > 0 || len(v.Retryables) > 0) {
+		client.retryConfig = v
+	}
+
+	if len(c.ServiceRateLimits) > 0 {
+		client.rateLimiters = make(map[string]*serviceRateLimiter, len(c.ServiceRateLimits))
+		for servicePackageName, limit := range c.ServiceRateLimits {
+			client.rateLimiters[servicePackageName] = newServiceRateLimiter(servicePackageName, limit)
+		}
+	}
+
 	return client, diags
 }
 
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	arn_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/arn"
+	organizations_sdkv2 "github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationstypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// verifyRegionAllowed returns an error if the specified AWS Region is not one of the allowed Regions.
+// An empty list of allowed Regions allows all Regions.
+func verifyRegionAllowed(allowedRegions []string, region string) error {
+	if len(allowedRegions) > 0 && !slices.Contains(allowedRegions, region) {
+		return fmt.Errorf("AWS Region not allowed: %s", region)
+	}
+
+	return nil
+}
+
+// verifyOrganizationAllowed returns an error if the specified AWS account is not a member of
+// one of the allowed AWS Organizations or organizational units.
+func (c *Config) verifyOrganizationAllowed(ctx context.Context, cfg aws_sdkv2.Config, accountID string) error {
+	if len(c.AllowedOrganizationIDs) == 0 && len(c.AllowedOrganizationPaths) == 0 {
+		return nil
+	}
+
+	if accountID == "" {
+		return fmt.Errorf("AWS account ID is required to verify AWS Organizations membership")
+	}
+
+	conn := organizations_sdkv2.NewFromConfig(cfg, func(o *organizations_sdkv2.Options) {
+		if endpoint := c.Endpoints[names.Organizations]; endpoint != "" {
+			o.BaseEndpoint = aws_sdkv2.String(endpoint)
+		}
+	})
+
+	// DescribeAccount can only be called from the organization's management account
+	// or by a member account that is a delegated administrator.
+	input := &organizations_sdkv2.DescribeAccountInput{
+		AccountId: aws_sdkv2.String(accountID),
+	}
+	output, err := conn.DescribeAccount(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("reading AWS Organizations Account (%s): %w", accountID, err)
+	}
+
+	organizationID, err := organizationIDFromAccountARN(aws_sdkv2.ToString(output.Account.Arn))
+	if err != nil {
+		return err
+	}
+
+	if len(c.AllowedOrganizationIDs) > 0 && !slices.Contains(c.AllowedOrganizationIDs, organizationID) {
+		return fmt.Errorf("AWS Organization not allowed: %s", organizationID)
+	}
+
+	if len(c.AllowedOrganizationPaths) > 0 {
+		path, err := organizationPath(ctx, conn, organizationID, accountID)
+		if err != nil {
+			return err
+		}
+
+		if !organizationPathAllowed(c.AllowedOrganizationPaths, path) {
+			return fmt.Errorf("AWS Organizations path not allowed: %s", path)
+		}
+	}
+
+	return nil
+}
+
+// organizationPathAllowed returns whether the specified AWS Organizations path is equal to,
+// or a descendant of, one of the allowed paths.
+func organizationPathAllowed(allowedPaths []string, path string) bool {
+	// Compare whole path elements so that "o-a1b2c3d4e5/r-ab12/ou-ab12-1" doesn't match "o-a1b2c3d4e5/r-ab12/ou-ab12-11/".
+	path = withTrailingSlash(path)
+
+	return slices.ContainsFunc(allowedPaths, func(v string) bool {
+		return strings.HasPrefix(path, withTrailingSlash(v))
+	})
+}
+
+func withTrailingSlash(s string) string {
+	if !strings.HasSuffix(s, "/") {
+		return s + "/"
+	}
+
+	return s
+}
+
+// organizationIDFromAccountARN returns the AWS Organization ID from an AWS Organizations account ARN
+// of the form "arn:aws:organizations::<management-account-id>:account/<organization-id>/<account-id>".
+func organizationIDFromAccountARN(s string) (string, error) {
+	v, err := arn_sdkv2.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("parsing AWS Organizations Account ARN (%s): %w", s, err)
+	}
+
+	parts := strings.Split(v.Resource, "/")
+	if len(parts) != 3 || parts[0] != "account" {
+		return "", fmt.Errorf("unexpected format for AWS Organizations Account ARN (%s)", s)
+	}
+
+	return parts[1], nil
+}
+
+// organizationPath returns the AWS Organizations path of the specified account's parent,
+// in the form used by the aws:PrincipalOrgPaths condition key, for example "o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/".
+func organizationPath(ctx context.Context, conn *organizations_sdkv2.Client, organizationID, accountID string) (string, error) {
+	var ids []string
+
+	id := accountID
+	for {
+		// ListParents can only be called from the organization's management account
+		// or by a member account that is a delegated administrator.
+		input := &organizations_sdkv2.ListParentsInput{
+			ChildId: aws_sdkv2.String(id),
+		}
+		output, err := conn.ListParents(ctx, input)
+
+		if err != nil {
+			return "", fmt.Errorf("listing AWS Organizations parents (%s): %w", id, err)
+		}
+
+		// Each account or organizational unit has exactly one parent.
+		if len(output.Parents) == 0 {
+			return "", fmt.Errorf("AWS Organizations parent (%s) not found", id)
+		}
+
+		parent := output.Parents[0]
+		ids = append(ids, aws_sdkv2.ToString(parent.Id))
+
+		if parent.Type == organizationstypes_sdkv2.ParentTypeRoot {
+			break
+		}
+
+		id = aws_sdkv2.ToString(parent.Id)
+	}
+
+	slices.Reverse(ids)
+
+	return organizationID + "/" + strings.Join(ids, "/") + "/", nil
+}
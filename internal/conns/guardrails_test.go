@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestVerifyRegionAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		allowedRegions []string
+		region         string
+		expectError    bool
+	}{
+		"no allowed regions": {
+			region: "us-west-2", //lintignore:AWSAT003
+		},
+		"allowed": {
+			allowedRegions: []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+			region:         "us-west-2",                        //lintignore:AWSAT003
+		},
+		"not allowed": {
+			allowedRegions: []string{"us-east-1"}, //lintignore:AWSAT003
+			region:         "eu-west-1",           //lintignore:AWSAT003
+			expectError:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifyRegionAllowed(testCase.allowedRegions, testCase.region)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("verifyRegionAllowed() error = %v, expectError %t", err, want)
+			}
+		})
+	}
+}
+
+func TestOrganizationPathAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		allowedPaths []string
+		path         string
+		expected     bool
+	}{
+		"equal": {
+			allowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-1/"},
+			path:         "o-a1b2c3d4e5/r-ab12/ou-ab12-1/",
+			expected:     true,
+		},
+		"descendant": {
+			allowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-1/"},
+			path:         "o-a1b2c3d4e5/r-ab12/ou-ab12-1/ou-ab12-2/",
+			expected:     true,
+		},
+		"no trailing slash": {
+			allowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-1"},
+			path:         "o-a1b2c3d4e5/r-ab12/ou-ab12-1/ou-ab12-2/",
+			expected:     true,
+		},
+		"sibling": {
+			allowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-1"},
+			path:         "o-a1b2c3d4e5/r-ab12/ou-ab12-12/",
+		},
+		"sibling with trailing slash": {
+			allowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-1/"},
+			path:         "o-a1b2c3d4e5/r-ab12/ou-ab12-12/",
+		},
+		"ancestor": {
+			allowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-1/"},
+			path:         "o-a1b2c3d4e5/r-ab12/",
+		},
+		"one of several": {
+			allowedPaths: []string{"o-a1b2c3d4e5/r-ab12/ou-ab12-2/", "o-a1b2c3d4e5/r-ab12/ou-ab12-1"},
+			path:         "o-a1b2c3d4e5/r-ab12/ou-ab12-1/",
+			expected:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := organizationPathAllowed(testCase.allowedPaths, testCase.path), testCase.expected; got != want {
+				t.Errorf("organizationPathAllowed() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestOrganizationIDFromAccountARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn         string
+		expected    string
+		expectError bool
+	}{
+		"valid": {
+			arn:      "arn:aws:organizations::111122223333:account/o-a1b2c3d4e5/444455556666", //lintignore:AWSAT005
+			expected: "o-a1b2c3d4e5",
+		},
+		"not an ARN": {
+			arn:         "o-a1b2c3d4e5",
+			expectError: true,
+		},
+		"not an account": {
+			arn:         "arn:aws:organizations::111122223333:ou/o-a1b2c3d4e5/ou-ab12-11111111", //lintignore:AWSAT005
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := organizationIDFromAccountARN(testCase.arn)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("organizationIDFromAccountARN() = %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// ServiceRateLimit configures client-side limits on the AWS API requests made by a service package.
+// Zero values mean no limit.
+type ServiceRateLimit struct {
+	MaxInFlight       int
+	RequestsPerSecond float64
+}
+
+const (
+	// Requests delayed by client-side rate limiting for longer than this are logged.
+	rateLimitDelayLogThreshold = 100 * time.Millisecond
+)
+
+// serviceRateLimiter enforces a ServiceRateLimit on each attempt of each AWS API request.
+type serviceRateLimiter struct {
+	inFlight           chan struct{}
+	limiter            *rate.Limiter
+	servicePackageName string
+	// AWS SDK for Go v1 requests that currently hold an in-flight slot.
+	sdkv1Acquired sync.Map
+}
+
+func newServiceRateLimiter(servicePackageName string, limit ServiceRateLimit) *serviceRateLimiter {
+	l := &serviceRateLimiter{
+		servicePackageName: servicePackageName,
+	}
+
+	if limit.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, limit.MaxInFlight)
+	}
+
+	if limit.RequestsPerSecond > 0 {
+		// Allow bursts of up to one second's worth of requests.
+		l.limiter = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), max(1, int(limit.RequestsPerSecond)))
+	}
+
+	return l
+}
+
+// acquire blocks until a request may be sent.
+// If no error is returned, release must be called once the request attempt has completed.
+func (l *serviceRateLimiter) acquire(ctx context.Context, operation string) error {
+	start := time.Now()
+
+	if l.limiter != nil {
+		if err := l.limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if delay := time.Since(start); delay >= rateLimitDelayLogThreshold {
+		tflog.Info(ctx, "AWS API request delayed by client-side rate limit", map[string]any{
+			"tf_aws.rate_limit.delay":           delay.String(),
+			"tf_aws.rate_limit.operation":       operation,
+			"tf_aws.rate_limit.service_package": l.servicePackageName,
+		})
+	}
+
+	return nil
+}
+
+func (l *serviceRateLimiter) release() {
+	if l.inFlight == nil {
+		return
+	}
+
+	select {
+	case <-l.inFlight:
+	default:
+	}
+}
+
+func (l *serviceRateLimiter) logThrottled(ctx context.Context, operation string, err error) {
+	tflog.Warn(ctx, "AWS API request throttled", map[string]any{
+		"error":                             err,
+		"tf_aws.rate_limit.operation":       operation,
+		"tf_aws.rate_limit.service_package": l.servicePackageName,
+	})
+}
+
+// sdkv2APIOption returns an AWS SDK for Go v2 API option that enforces the rate limit.
+func (l *serviceRateLimiter) sdkv2APIOption() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Add after the Retry middleware so that each attempt is limited.
+		return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("TFServiceRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			operation := awsmiddleware.GetOperationName(ctx)
+
+			if err := l.acquire(ctx, operation); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+			defer l.release()
+
+			out, metadata, err := next.HandleFinalize(ctx, in)
+
+			if err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err) == aws_sdkv2.TrueTernary {
+				l.logThrottled(ctx, operation, err)
+			}
+
+			return out, metadata, err
+		}), middleware.After)
+	}
+}
+
+// sdkv1Handlers adds AWS SDK for Go v1 handlers that enforce the rate limit.
+func (l *serviceRateLimiter) sdkv1Handlers(handlers *request.Handlers) {
+	// Signing is repeated for each attempt and is immediately followed by sending.
+	handlers.Sign.PushBackNamed(request.NamedHandler{
+		Name: "TFServiceRateLimitAcquire",
+		Fn: func(r *request.Request) {
+			// Signing has already failed so the request won't be sent.
+			if r.Error != nil {
+				return
+			}
+
+			// Presigned requests are sent by someone else.
+			if r.IsPresigned() {
+				return
+			}
+
+			if err := l.acquire(r.Context(), r.Operation.Name); err != nil {
+				r.Error = err
+				return
+			}
+
+			l.sdkv1Acquired.Store(r, struct{}{})
+		},
+	})
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "TFServiceRateLimitRelease",
+		Fn: func(r *request.Request) {
+			l.sdkv1Release(r)
+
+			if r.Error != nil && request.IsErrorThrottle(r.Error) {
+				l.logThrottled(r.Context(), r.Operation.Name, r.Error)
+			}
+		},
+	})
+	// CompleteAttempt handlers aren't run if a later Sign handler fails.
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "TFServiceRateLimitRelease",
+		Fn:   l.sdkv1Release,
+	})
+}
+
+// sdkv1Release releases the in-flight slot held by the specified request, if any.
+func (l *serviceRateLimiter) sdkv1Release(r *request.Request) {
+	if _, ok := l.sdkv1Acquired.LoadAndDelete(r); ok {
+		l.release()
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestServiceRateLimiterMaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newServiceRateLimiter("test", ServiceRateLimit{MaxInFlight: 2})
+
+	var inFlight, peak atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := l.acquire(ctx, "Test"); err != nil {
+				t.Errorf("acquire: %s", err)
+				return
+			}
+			defer l.release()
+
+			n := inFlight.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			inFlight.Add(-1)
+		}()
+	}
+	wg.Wait()
+
+	if got, want := peak.Load(), int32(2); got != want {
+		t.Errorf("peak in-flight requests = %d, want %d", got, want)
+	}
+}
+
+func TestServiceRateLimiterRequestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := newServiceRateLimiter("test", ServiceRateLimit{RequestsPerSecond: 20})
+
+	start := time.Now()
+	// The first second's worth of requests is allowed as a burst.
+	for range 30 {
+		if err := l.acquire(ctx, "Test"); err != nil {
+			t.Fatalf("acquire: %s", err)
+		}
+		l.release()
+	}
+
+	if got, want := time.Since(start), 450*time.Millisecond; got < want {
+		t.Errorf("elapsed = %s, want at least %s", got, want)
+	}
+}
+
+func TestServiceRateLimiterCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	l := newServiceRateLimiter("test", ServiceRateLimit{MaxInFlight: 1})
+
+	if err := l.acquire(ctx, "Test"); err != nil {
+		t.Fatalf("acquire: %s", err)
+	}
+
+	cancel()
+
+	if err := l.acquire(ctx, "Test"); err == nil {
+		t.Error("expected error, got none")
+	}
+}
+
+func TestServiceRateLimiterSDKv1SignFailure(t *testing.T) {
+	t.Parallel()
+
+	signErr := errors.New("signing failed")
+	failSign := request.NamedHandler{
+		Name: "TestFailSign",
+		Fn: func(r *request.Request) {
+			r.Error = signErr
+		},
+	}
+
+	testCases := map[string]struct {
+		setup func(*serviceRateLimiter, *request.Handlers)
+	}{
+		"before acquire": {
+			setup: func(l *serviceRateLimiter, handlers *request.Handlers) {
+				handlers.Sign.PushBackNamed(failSign)
+				l.sdkv1Handlers(handlers)
+			},
+		},
+		"after acquire": {
+			setup: func(l *serviceRateLimiter, handlers *request.Handlers) {
+				l.sdkv1Handlers(handlers)
+				handlers.Sign.PushBackNamed(failSign)
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			l := newServiceRateLimiter("test", ServiceRateLimit{MaxInFlight: 1})
+			var handlers request.Handlers
+			testCase.setup(l, &handlers)
+
+			for range 5 {
+				r := request.New(aws_sdkv1.Config{}, metadata.ClientInfo{}, handlers, nil, &request.Operation{Name: "Test"}, nil, nil)
+				r.SetContext(ctx)
+
+				if err := r.Send(); !errors.Is(err, signErr) {
+					t.Fatalf("Send error = %v, want %v", err, signErr)
+				}
+			}
+
+			if err := l.acquire(ctx, "Test"); err != nil {
+				t.Errorf("acquire: %s", err)
+			}
+		})
+	}
+}
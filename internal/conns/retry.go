@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// RetryConfig configures retries of AWS API requests in addition to the SDK and service package defaults.
+type RetryConfig struct {
+	// Budget is the maximum time spent on an AWS API call, including retries.
+	// No further attempts are made once it has elapsed. Zero means no limit.
+	Budget time.Duration
+	// MaxBackoff is the maximum delay between attempts. Zero means the provider default.
+	MaxBackoff time.Duration
+	Retryables []RetryableErrors
+}
+
+// RetryableErrors are AWS API errors that are retried.
+type RetryableErrors struct {
+	// ErrorCodes are matched exactly.
+	ErrorCodes []string
+	// ErrorMessages are matched as substrings of the error message.
+	ErrorMessages []string
+	// Services are the service package names the errors are retried for. Empty means all services.
+	Services []string
+}
+
+func (re RetryableErrors) matches(code, message string) bool {
+	if slices.Contains(re.ErrorCodes, code) {
+		return true
+	}
+
+	for _, v := range re.ErrorMessages {
+		if strings.Contains(message, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serviceRetryer applies a RetryConfig to the AWS API clients for a service package.
+type serviceRetryer struct {
+	budget     time.Duration
+	retryables []RetryableErrors
+}
+
+func newServiceRetryer(servicePackageName string, config *RetryConfig) *serviceRetryer {
+	r := &serviceRetryer{
+		budget: config.Budget,
+	}
+
+	for _, v := range config.Retryables {
+		if len(v.Services) == 0 || slices.Contains(v.Services, servicePackageName) {
+			r.retryables = append(r.retryables, v)
+		}
+	}
+
+	return r
+}
+
+func (r *serviceRetryer) isRetryable(code, message string) bool {
+	return slices.ContainsFunc(r.retryables, func(v RetryableErrors) bool {
+		return v.matches(code, message)
+	})
+}
+
+func (r *serviceRetryer) isBudgetExhausted(start time.Time) bool {
+	return r.budget > 0 && time.Since(start) >= r.budget
+}
+
+type retryStartKey struct{}
+
+// sdkv2APIOption returns an AWS SDK for Go v2 API option that records the start of each call.
+func (r *serviceRetryer) sdkv2APIOption() func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Add before the Retry middleware so that the start of the call, rather than of each attempt, is recorded.
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TFRetryBudget", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			return next.HandleInitialize(context.WithValue(ctx, retryStartKey{}, time.Now()), in)
+		}), middleware.After)
+	}
+}
+
+// sdkv2Retryer returns an AWS SDK for Go v2 Retryer factory that wraps the specified one.
+func (r *serviceRetryer) sdkv2Retryer(f func() aws_sdkv2.Retryer) func() aws_sdkv2.Retryer {
+	if f == nil {
+		return nil
+	}
+
+	return func() aws_sdkv2.Retryer {
+		v, ok := f().(aws_sdkv2.RetryerV2)
+		if !ok {
+			return f()
+		}
+
+		return &budgetRetryer{
+			RetryerV2: AddIsErrorRetryables(v, retry.IsErrorRetryableFunc(func(err error) aws_sdkv2.Ternary {
+				if apiErr, ok := errs.As[smithy.APIError](err); ok && r.isRetryable(apiErr.ErrorCode(), apiErr.ErrorMessage()) {
+					return aws_sdkv2.TrueTernary
+				}
+				return aws_sdkv2.UnknownTernary
+			})),
+			r: r,
+		}
+	}
+}
+
+// budgetRetryer stops retrying once the retry budget is exhausted.
+type budgetRetryer struct {
+	aws_sdkv2.RetryerV2
+	r *serviceRetryer
+}
+
+// GetRetryToken is called before each retry, with the context of the call.
+func (r *budgetRetryer) GetRetryToken(ctx context.Context, opErr error) (func(error) error, error) {
+	if start, ok := ctx.Value(retryStartKey{}).(time.Time); ok && r.r.isBudgetExhausted(start) {
+		tflog.Debug(ctx, "AWS API request retry budget exhausted", map[string]any{
+			"tf_aws.retry.budget": r.r.budget.String(),
+		})
+		return nil, fmt.Errorf("retry budget (%s) exhausted: %w", r.r.budget, opErr)
+	}
+
+	return r.RetryerV2.GetRetryToken(ctx, opErr)
+}
+
+// sdkv1Handlers adds AWS SDK for Go v1 handlers that apply the retry configuration.
+func (r *serviceRetryer) sdkv1Handlers(handlers *request.Handlers) {
+	handlers.Retry.PushBackNamed(request.NamedHandler{
+		Name: "TFRetry",
+		Fn: func(req *request.Request) {
+			if req.Error == nil {
+				return
+			}
+
+			if r.isBudgetExhausted(req.Time) {
+				tflog.Debug(req.Context(), "AWS API request retry budget exhausted", map[string]any{
+					"tf_aws.retry.budget": r.budget.String(),
+				})
+				req.Retryable = aws_sdkv1.Bool(false)
+				return
+			}
+
+			var awsErr awserr.Error
+			if errors.As(req.Error, &awsErr) && r.isRetryable(awsErr.Code(), awsErr.Message()) {
+				req.Retryable = aws_sdkv1.Bool(true)
+			}
+		},
+	})
+}
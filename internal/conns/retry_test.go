@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestServiceRetryerIsErrorRetryable(t *testing.T) {
+	t.Parallel()
+
+	config := &RetryConfig{
+		Retryables: []RetryableErrors{
+			{
+				ErrorCodes: []string{"AccessDenied"},
+				Services:   []string{"iam"},
+			},
+			{
+				ErrorMessages: []string{"explicit deny in a service control policy"},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		servicePackageName string
+		err                error
+		expected           bool
+	}{
+		"no match": {
+			servicePackageName: "iam",
+			err:                errs.APIError("ValidationError", "invalid"),
+		},
+		"code match": {
+			servicePackageName: "iam",
+			err:                errs.APIError("AccessDenied", "not authorized"),
+			expected:           true,
+		},
+		"code match other service": {
+			servicePackageName: "ec2",
+			err:                errs.APIError("AccessDenied", "not authorized"),
+		},
+		"message match": {
+			servicePackageName: "ec2",
+			err:                errs.APIError("UnauthorizedOperation", "with an explicit deny in a service control policy"),
+			expected:           true,
+		},
+		"default retryable": {
+			servicePackageName: "ec2",
+			err:                errs.APIError("Throttling", "Rate exceeded"),
+			expected:           true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := newServiceRetryer(testCase.servicePackageName, config)
+			retryer := r.sdkv2Retryer(func() aws_sdkv2.Retryer { return retry.NewStandard() })()
+
+			if got, want := retryer.IsErrorRetryable(testCase.err), testCase.expected; got != want {
+				t.Errorf("IsErrorRetryable = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestServiceRetryerBudget(t *testing.T) {
+	t.Parallel()
+
+	r := newServiceRetryer("iam", &RetryConfig{Budget: time.Minute})
+	retryer := r.sdkv2Retryer(func() aws_sdkv2.Retryer { return retry.NewStandard() })().(aws_sdkv2.RetryerV2)
+	opErr := errs.APIError("Throttling", "Rate exceeded")
+
+	ctx := context.WithValue(context.Background(), retryStartKey{}, time.Now())
+	if _, err := retryer.GetRetryToken(ctx, opErr); err != nil {
+		t.Errorf("GetRetryToken within budget: %s", err)
+	}
+
+	ctx = context.WithValue(context.Background(), retryStartKey{}, time.Now().Add(-2*time.Minute))
+	if _, err := retryer.GetRetryToken(ctx, opErr); err == nil {
+		t.Error("GetRetryToken with budget exhausted: expected error")
+	} else if !errors.Is(err, opErr) {
+		t.Errorf("GetRetryToken with budget exhausted: %s does not wrap %s", err, opErr)
+	}
+}
+
+func TestServiceRetryerSDKv1Handlers(t *testing.T) {
+	t.Parallel()
+
+	r := newServiceRetryer("iam", &RetryConfig{
+		Budget: time.Minute,
+		Retryables: []RetryableErrors{
+			{
+				ErrorCodes: []string{"AccessDenied"},
+			},
+		},
+	})
+
+	testCases := map[string]struct {
+		err      error
+		start    time.Time
+		expected *bool
+	}{
+		"no match": {
+			err:   awserr.New("ValidationError", "invalid", nil),
+			start: time.Now(),
+		},
+		"match": {
+			err:      awserr.New("AccessDenied", "not authorized", nil),
+			start:    time.Now(),
+			expected: aws_sdkv1.Bool(true),
+		},
+		"budget exhausted": {
+			err:      awserr.New("AccessDenied", "not authorized", nil),
+			start:    time.Now().Add(-2 * time.Minute),
+			expected: aws_sdkv1.Bool(false),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var handlers request.Handlers
+			r.sdkv1Handlers(&handlers)
+
+			req := &request.Request{
+				Error: testCase.err,
+				Time:  testCase.start,
+			}
+			handlers.Retry.Run(req)
+
+			if got, want := aws_sdkv1.BoolValue(req.Retryable), aws_sdkv1.BoolValue(testCase.expected); got != want || (req.Retryable == nil) != (testCase.expected == nil) {
+				t.Errorf("Retryable = %v, want %v", req.Retryable, testCase.expected)
+			}
+		})
+	}
+}
@@ -66,6 +66,15 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For tests run against a local AWS API emulator, the base URL of the emulator
+	// All service endpoints are configured from this URL
+	AccEmulatorEndpoint = "TF_ACC_EMULATOR_ENDPOINT"
+
+	// For tests run against a local AWS API emulator, a comma-separated list of the service IDs
+	// the emulator supports, e.g. "DynamoDB,S3,SQS"
+	// Tests for other services are skipped. If empty, all services are assumed to be supported
+	AccEmulatorServices = "TF_ACC_EMULATOR_SERVICES"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
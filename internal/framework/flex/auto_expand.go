@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -14,7 +16,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
-	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 // Expand  = TF -->  AWS
@@ -267,29 +268,34 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 		return diags
 
 	case reflect.Struct:
-		//
-		// timetypes.RFC3339 --> time.Time
-		//
-		if t, ok := vFrom.(timetypes.RFC3339); ok {
-			v, d := t.ValueRFC3339Time()
+		if tTo == reflect.TypeFor[time.Time]() {
+			//
+			// timetypes.RFC3339/types.String --> time.Time
+			//
+			t, d := expandTime(vFrom, v)
 			diags.Append(d...)
 			if diags.HasError() {
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(v))
+			vTo.Set(reflect.ValueOf(t))
 			return diags
 		}
 
 	case reflect.Interface:
-		if s, ok := vFrom.(fwtypes.SmithyJSON[smithyjson.JSONStringer]); ok {
-			v, d := s.ValueInterface()
+		//
+		// fwtypes.SmithyJSON --> document.Interface
+		//
+		if s, ok := vFrom.(smithyDocumentValuable); ok {
+			v, d := s.ValueSmithyDocument()
 			diags.Append(d...)
 			if diags.HasError() {
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(v))
+			if v := reflect.ValueOf(v); v.IsValid() && v.Type().AssignableTo(tTo) {
+				vTo.Set(v)
+			}
 			return diags
 		}
 
@@ -303,17 +309,17 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 			return diags
 
 		case reflect.Struct:
-			//
-			// timetypes.RFC3339 --> *time.Time
-			//
-			if t, ok := vFrom.(timetypes.RFC3339); ok {
-				v, d := t.ValueRFC3339Time()
+			if tElem == reflect.TypeFor[time.Time]() {
+				//
+				// timetypes.RFC3339/types.String --> *time.Time
+				//
+				t, d := expandTime(vFrom, v)
 				diags.Append(d...)
 				if diags.HasError() {
 					return diags
 				}
 
-				vTo.Set(reflect.ValueOf(&v))
+				vTo.Set(reflect.ValueOf(&t))
 				return diags
 			}
 		}
@@ -327,6 +333,28 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 	return diags
 }
 
+// smithyDocumentValuable is implemented by Plugin Framework values that can be converted to Smithy documents.
+type smithyDocumentValuable interface {
+	ValueSmithyDocument() (any, diag.Diagnostics)
+}
+
+// expandTime converts a Plugin Framework RFC3339 or String value to a time.
+func expandTime(vFrom basetypes.StringValuable, v basetypes.StringValue) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if t, ok := vFrom.(timetypes.RFC3339); ok {
+		return t.ValueRFC3339Time()
+	}
+
+	t, err := time.Parse(time.RFC3339, v.ValueString())
+	if err != nil {
+		diags.AddError("AutoFlEx", fmt.Sprintf("parsing time: %s", err))
+		return time.Time{}, diags
+	}
+
+	return t, diags
+}
+
 // string copies a Plugin Framework Object(ish) value to a compatible AWS API value.
 func (expander autoExpander) object(ctx context.Context, vFrom basetypes.ObjectValuable, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -358,6 +386,15 @@ func (expander autoExpander) object(ctx context.Context, vFrom basetypes.ObjectV
 				return diags
 			}
 		}
+
+	case reflect.Interface:
+		//
+		// types.Object -> union.
+		//
+		if vFrom, ok := vFrom.(fwtypes.NestedObjectValue); ok {
+			diags.Append(expander.nestedObjectToUnion(ctx, vFrom, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
@@ -704,17 +741,17 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 
 		case reflect.Interface:
 			//
-			// types.List(OfObject) -> []interface.
+			// types.List(OfObject) -> []union.
 			//
-			// Smithy union type handling not yet implemented. Silently skip.
+			diags.Append(expander.nestedObjectToUnionSlice(ctx, vFrom, tTo, vTo)...)
 			return diags
 		}
 
 	case reflect.Interface:
 		//
-		// types.List(OfObject) -> interface.
+		// types.List(OfObject) -> union.
 		//
-		// Smithy union type handling not yet implemented. Silently skip.
+		diags.Append(expander.nestedObjectToUnion(ctx, vFrom, vTo)...)
 		return diags
 	}
 
@@ -786,6 +823,170 @@ func (expander autoExpander) nestedObjectToSlice(ctx context.Context, vFrom fwty
 	return diags
 }
 
+// nestedObjectToUnion copies a Plugin Framework NestedObjectValue to a compatible AWS API Smithy union value.
+func (expander autoExpander) nestedObjectToUnion(ctx context.Context, vFrom fwtypes.NestedObjectValue, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := reflect.ValueOf(from); !v.IsValid() || v.IsNil() {
+		return diags
+	}
+
+	to, d := expander.union(ctx, from, vTo.Type())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if to.IsValid() {
+		vTo.Set(to)
+	}
+
+	return diags
+}
+
+// nestedObjectToUnionSlice copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API []union value.
+func (expander autoExpander) nestedObjectToUnionSlice(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tSlice reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Objects as a slice.
+	from, d := vFrom.ToObjectSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Create a new target slice and expand each element.
+	f := reflect.ValueOf(from)
+	n := f.Len()
+	t := reflect.MakeSlice(tSlice, 0, n)
+	for i := 0; i < n; i++ {
+		to, d := expander.union(ctx, f.Index(i).Interface(), tSlice.Elem())
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if to.IsValid() {
+			t = reflect.Append(t, to)
+		}
+	}
+
+	vTo.Set(t)
+
+	return diags
+}
+
+// union expands the Plugin Framework struct pointed to by from to a member of the Smithy union type tUnion.
+// Each of the struct's fields corresponds to a union member, named <union>Member<field> or by the field's `autoflex` tag.
+// Only one field may be set.
+func (expander autoExpander) union(ctx context.Context, from any, tUnion reflect.Type) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	members := expander.Options.UnionMembers(tUnion)
+	if len(members) == 0 {
+		tflog.Info(ctx, "AutoFlex Expand; no union members registered", map[string]interface{}{
+			"to": tUnion,
+		})
+
+		return reflect.Value{}, diags
+	}
+
+	var to reflect.Value
+	var toField string
+	valFrom := reflect.ValueOf(from).Elem()
+	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
+		field := typFrom.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		tag := parseAutoFlexTag(field)
+		if tag.ignore {
+			continue
+		}
+
+		v, ok := valFrom.Field(i).Interface().(attr.Value)
+		if !ok {
+			diags.AddError("AutoFlEx", fmt.Sprintf("does not implement attr.Value: %s", field.Name))
+			return reflect.Value{}, diags
+		}
+
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		// An empty block does not select a member.
+		if v, ok := v.(interface{ Elements() []attr.Value }); ok && len(v.Elements()) == 0 {
+			continue
+		}
+
+		if to.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("more than one member of union %s set: %s, %s", tUnion, toField, field.Name))
+			return reflect.Value{}, diags
+		}
+
+		name := field.Name
+		if tag.name != "" {
+			name = tag.name
+		}
+
+		tMember := findUnionMember(tUnion, members, name)
+		if tMember == nil {
+			diags.AddError("AutoFlEx", fmt.Sprintf("no member of union %s registered for %s", tUnion, field.Name))
+			return reflect.Value{}, diags
+		}
+
+		// Create the member and expand its value.
+		if tMember.Kind() == reflect.Ptr {
+			to = reflect.New(tMember.Elem())
+		} else {
+			to = reflect.New(tMember).Elem()
+		}
+		toField = field.Name
+
+		vValue := reflect.Indirect(to).FieldByName("Value")
+		if !vValue.IsValid() {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union member %s has no Value field", tMember))
+			return reflect.Value{}, diags
+		}
+
+		diags.Append(expander.convert(ctx, valFrom.Field(i), vValue)...)
+		if diags.HasError() {
+			return reflect.Value{}, diags
+		}
+	}
+
+	return to, diags
+}
+
+// findUnionMember returns the member of the Smithy union type tUnion with the specified name.
+func findUnionMember(tUnion reflect.Type, members []reflect.Type, name string) reflect.Type {
+	for _, member := range members {
+		if strings.EqualFold(unionMemberName(tUnion, member), name) {
+			return member
+		}
+	}
+
+	return nil
+}
+
+// unionMemberName returns the name of a Smithy union member, e.g. "S3Uri" for SourceMemberS3Uri.
+func unionMemberName(tUnion, tMember reflect.Type) string {
+	if tMember.Kind() == reflect.Ptr {
+		tMember = tMember.Elem()
+	}
+
+	name, _ := strings.CutPrefix(tMember.Name(), tUnion.Name()+"Member")
+
+	return name
+}
+
 // nestedKeyObjectToMap copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API map[string]struct value.
 func (expander autoExpander) nestedKeyObjectToMap(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tElem reflect.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
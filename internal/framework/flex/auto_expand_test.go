@@ -994,6 +994,192 @@ func TestExpandOptions(t *testing.T) {
 	runAutoExpandTestCases(ctx, t, testCases)
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	unionMembers := WithUnionMembers[TestFlexAWSUnion](
+		&TestFlexAWSUnionMemberS3Uri{},
+		&TestFlexAWSUnionMemberConfiguration{},
+		&TestFlexAWSUnionMemberInlineText{},
+	)
+	testCases := autoFlexTestCases{
+		{
+			TestName: "no members registered",
+			Source: &TestFlexTF26{Source: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTFUnion01{
+				S3URI:         types.StringValue("s3://bucket/key"),
+				Configuration: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				Text:          types.StringNull(),
+			})},
+			Target:     &TestFlexAWS26{},
+			WantTarget: &TestFlexAWS26{},
+		},
+		{
+			TestName: "null union",
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			Source: &TestFlexTF26{
+				Source: fwtypes.NewListNestedObjectValueOfNull[TestFlexTFUnion01](ctx),
+			},
+			Target:     &TestFlexAWS26{},
+			WantTarget: &TestFlexAWS26{},
+		},
+		{
+			TestName: "scalar member",
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			Source: &TestFlexTF26{Source: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTFUnion01{
+				S3URI:         types.StringValue("s3://bucket/key"),
+				Configuration: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				Text:          types.StringNull(),
+			})},
+			Target:     &TestFlexAWS26{},
+			WantTarget: &TestFlexAWS26{Source: &TestFlexAWSUnionMemberS3Uri{Value: "s3://bucket/key"}},
+		},
+		{
+			TestName: "nested object member",
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			Source: &TestFlexTF26{Source: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTFUnion01{
+				S3URI:         types.StringNull(),
+				Configuration: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+				Text:          types.StringNull(),
+			})},
+			Target:     &TestFlexAWS26{},
+			WantTarget: &TestFlexAWS26{Source: &TestFlexAWSUnionMemberConfiguration{Value: TestFlexAWS01{Field1: "a"}}},
+		},
+		{
+			TestName: "member named by tag",
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			Source: &TestFlexTF26{Source: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTFUnion01{
+				S3URI:         types.StringNull(),
+				Configuration: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []TestFlexTF01{}),
+				Text:          types.StringValue("text"),
+			})},
+			Target:     &TestFlexAWS26{},
+			WantTarget: &TestFlexAWS26{Source: &TestFlexAWSUnionMemberInlineText{Value: "text"}},
+		},
+		{
+			TestName: "object",
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			Source: &TestFlexTF28{Source: fwtypes.NewObjectValueOfMust(ctx, &TestFlexTFUnion01{
+				S3URI:         types.StringValue("s3://bucket/key"),
+				Configuration: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				Text:          types.StringNull(),
+			})},
+			Target:     &TestFlexAWS26{},
+			WantTarget: &TestFlexAWS26{Source: &TestFlexAWSUnionMemberS3Uri{Value: "s3://bucket/key"}},
+		},
+		{
+			TestName: "more than one member set",
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			Source: &TestFlexTF26{Source: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTFUnion01{
+				S3URI:         types.StringValue("s3://bucket/key"),
+				Configuration: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				Text:          types.StringValue("text"),
+			})},
+			Target:  &TestFlexAWS26{},
+			WantErr: true,
+		},
+		{
+			TestName: "member not registered",
+			Options:  []AutoFlexOptionsFunc{WithUnionMembers[TestFlexAWSUnion](&TestFlexAWSUnionMemberS3Uri{})},
+			Source: &TestFlexTF26{Source: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTFUnion01{
+				S3URI:         types.StringNull(),
+				Configuration: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				Text:          types.StringValue("text"),
+			})},
+			Target:  &TestFlexAWS26{},
+			WantErr: true,
+		},
+		{
+			TestName: "slice of unions",
+			Options:  []AutoFlexOptionsFunc{unionMembers},
+			Source: &TestFlexTF27{Sources: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []TestFlexTFUnion01{
+				{
+					S3URI:         types.StringValue("s3://bucket/key"),
+					Configuration: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					Text:          types.StringNull(),
+				},
+				{
+					S3URI:         types.StringNull(),
+					Configuration: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					Text:          types.StringValue("text"),
+				},
+			})},
+			Target: &TestFlexAWS27{},
+			WantTarget: &TestFlexAWS27{Sources: []TestFlexAWSUnion{
+				&TestFlexAWSUnionMemberS3Uri{Value: "s3://bucket/key"},
+				&TestFlexAWSUnionMemberInlineText{Value: "text"},
+			}},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandAutoFlexTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "name, omitempty and ignore",
+			Source: &TestFlexTF29{
+				Identifier:  types.StringValue("id"),
+				Description: types.StringValue(""),
+				Name:        types.StringValue("name"),
+			},
+			Target:     &TestFlexAWS29{},
+			WantTarget: &TestFlexAWS29{Id: aws.String("id")},
+		},
+		{
+			TestName: "omitempty with value",
+			Source: &TestFlexTF29{
+				Identifier:  types.StringNull(),
+				Description: types.StringValue("description"),
+				Name:        types.StringNull(),
+			},
+			Target:     &TestFlexAWS29{},
+			WantTarget: &TestFlexAWS29{Description: aws.String("description")},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandTimeAndDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testTimeStr := "2013-09-25T09:34:01Z"
+	testTimeTime := errs.Must(time.Parse(time.RFC3339, testTimeStr))
+
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "types.String to *time.Time",
+			Source:     &TestFlexTimeTF02{CreationDateTime: types.StringValue(testTimeStr)},
+			Target:     &TestFlexTimeAWS01{},
+			WantTarget: &TestFlexTimeAWS01{CreationDateTime: &testTimeTime},
+		},
+		{
+			TestName:   "types.String to time.Time",
+			Source:     &TestFlexTimeTF02{CreationDateTime: types.StringValue(testTimeStr)},
+			Target:     &TestFlexTimeAWS02{},
+			WantTarget: &TestFlexTimeAWS02{CreationDateTime: testTimeTime},
+		},
+		{
+			TestName: "invalid types.String to time.Time",
+			Source:   &TestFlexTimeTF02{CreationDateTime: types.StringValue("yesterday")},
+			Target:   &TestFlexTimeAWS02{},
+			WantErr:  true,
+		},
+		{
+			TestName:   "named document interface",
+			Source:     &TestFlexTF25{Field1: fwtypes.SmithyJSONValue(`{"field1": "a"}`, newTestFlexDocument)},
+			Target:     &TestFlexAWS25{},
+			WantTarget: &TestFlexAWS25{Field1: &testJSONDocument{Value: map[string]any{"field1": "a"}}},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
 type autoFlexTestCase struct {
 	Context    context.Context //nolint:containedctx // testing context use
 	Options    []AutoFlexOptionsFunc
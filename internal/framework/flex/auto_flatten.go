@@ -111,7 +111,7 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, false, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
 		return diags
 	}
 
@@ -332,6 +332,13 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
+	case fwtypes.NestedObjectType:
+		//
+		// union -> types.List(OfObject) or types.Object.
+		//
+		diags.Append(flattener.unionToNestedObject(ctx, vFrom, isNullFrom, tTo, vTo)...)
+		return diags
+
 	case basetypes.StringTypable:
 		stringValue := types.StringNull()
 		if !isNullFrom {
@@ -390,6 +397,24 @@ func (flattener autoFlattener) struct_(ctx context.Context, vFrom reflect.Value,
 		return diags
 	}
 
+	if tTo, ok := tTo.(basetypes.StringTypable); ok && (isNilFrom || vFrom.Type() == reflect.TypeFor[time.Time]()) {
+		//
+		// time.Time -> types.String.
+		//
+		stringValue := types.StringNull()
+		if !isNilFrom {
+			stringValue = types.StringValue(vFrom.Interface().(time.Time).Format(time.RFC3339))
+		}
+		v, d := tTo.ValueFromString(ctx, stringValue)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(v))
+		return diags
+	}
+
 	return diags
 }
 
@@ -564,8 +589,13 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 		}
 
 	case reflect.Interface:
-		// Smithy union type handling not yet implemented. Silently skip.
-		return diags
+		if tTo, ok := tTo.(fwtypes.NestedObjectCollectionType); ok {
+			//
+			// []union -> types.List(OfObject).
+			//
+			diags.Append(flattener.sliceOfUnionNestedObjectCollection(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -937,6 +967,155 @@ func (flattener autoFlattener) sliceOfStructNestedObjectCollection(ctx context.C
 	return diags
 }
 
+// unionToNestedObject copies an AWS API Smithy union value to a compatible Plugin Framework NestedObjectValue value.
+func (flattener autoFlattener) unionToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if isNullFrom {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target structure and flatten the union member into it.
+	to, d := tTo.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(flattener.union(ctx, vFrom, to)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Set the target structure as a mapped Object.
+	val, d := tTo.ValueFromObjectPtr(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// sliceOfUnionNestedObjectCollection copies an AWS API []union value to a compatible Plugin Framework NestedObjectCollectionValue value.
+func (flattener autoFlattener) sliceOfUnionNestedObjectCollection(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectCollectionType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target slice and flatten each element.
+	n := vFrom.Len()
+	to, d := tTo.NewObjectSlice(ctx, n, n)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	t := reflect.ValueOf(to)
+	for i := 0; i < n; i++ {
+		target, d := tTo.NewObjectPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(flattener.union(ctx, vFrom.Index(i), target)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		t.Index(i).Set(reflect.ValueOf(target))
+	}
+
+	// Set the target structure as a nested Object.
+	val, d := tTo.ValueFromObjectSlice(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// union flattens the member of the Smithy union vFrom into the Plugin Framework struct pointed to by to.
+// The member's value is copied to the struct field named for the member, directly or by the field's `autoflex` tag,
+// and all other fields are set to null.
+func (flattener autoFlattener) union(ctx context.Context, vFrom reflect.Value, to any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo := reflect.ValueOf(to).Elem()
+	typTo := valTo.Type()
+
+	var name string
+	var vValue reflect.Value
+	if vFrom.Kind() == reflect.Interface && !vFrom.IsNil() {
+		vMember := vFrom.Elem()
+		name = unionMemberName(vFrom.Type(), vMember.Type())
+		vValue = reflect.Indirect(vMember).FieldByName("Value")
+	}
+
+	matched := false
+	for i := 0; i < typTo.NumField(); i++ {
+		field := typTo.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		tag := parseAutoFlexTag(field)
+		if tag.ignore {
+			continue
+		}
+
+		fieldName := field.Name
+		if tag.name != "" {
+			fieldName = tag.name
+		}
+
+		vField := valTo.Field(i)
+		if !matched && vValue.IsValid() && strings.EqualFold(fieldName, name) {
+			matched = true
+
+			diags.Append(flattener.convert(ctx, vValue, vField)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			continue
+		}
+
+		diags.Append(setNull(ctx, vField)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !matched {
+		tflog.Info(ctx, "AutoFlex Flatten; union member not found", map[string]interface{}{
+			"from": vFrom.Type(),
+			"to":   typTo,
+		})
+	}
+
+	return diags
+}
+
 // blockKeyMapSet takes a struct and assigns the value of the `key`
 func blockKeyMapSet(to any, key reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
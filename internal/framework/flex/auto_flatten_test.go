@@ -1153,6 +1153,152 @@ func TestFlattenOptions(t *testing.T) {
 	runAutoFlattenTestCases(ctx, t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "nil union",
+			Source:     &TestFlexAWS26{},
+			Target:     &TestFlexTF26{},
+			WantTarget: &TestFlexTF26{Source: fwtypes.NewListNestedObjectValueOfNull[TestFlexTFUnion01](ctx)},
+		},
+		{
+			TestName: "scalar member",
+			Source:   &TestFlexAWS26{Source: &TestFlexAWSUnionMemberS3Uri{Value: "s3://bucket/key"}},
+			Target:   &TestFlexTF26{},
+			WantTarget: &TestFlexTF26{Source: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTFUnion01{
+				S3URI:         types.StringValue("s3://bucket/key"),
+				Configuration: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				Text:          types.StringNull(),
+			})},
+		},
+		{
+			TestName: "nested object member",
+			Source:   &TestFlexAWS26{Source: &TestFlexAWSUnionMemberConfiguration{Value: TestFlexAWS01{Field1: "a"}}},
+			Target:   &TestFlexTF26{},
+			WantTarget: &TestFlexTF26{Source: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTFUnion01{
+				S3URI:         types.StringNull(),
+				Configuration: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("a")}),
+				Text:          types.StringNull(),
+			})},
+		},
+		{
+			TestName: "member named by tag",
+			Source:   &TestFlexAWS26{Source: &TestFlexAWSUnionMemberInlineText{Value: "text"}},
+			Target:   &TestFlexTF26{},
+			WantTarget: &TestFlexTF26{Source: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTFUnion01{
+				S3URI:         types.StringNull(),
+				Configuration: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				Text:          types.StringValue("text"),
+			})},
+		},
+		{
+			TestName: "object",
+			Source:   &TestFlexAWS26{Source: &TestFlexAWSUnionMemberS3Uri{Value: "s3://bucket/key"}},
+			Target:   &TestFlexTF28{},
+			WantTarget: &TestFlexTF28{Source: fwtypes.NewObjectValueOfMust(ctx, &TestFlexTFUnion01{
+				S3URI:         types.StringValue("s3://bucket/key"),
+				Configuration: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+				Text:          types.StringNull(),
+			})},
+		},
+		{
+			TestName: "slice of unions",
+			Source: &TestFlexAWS27{Sources: []TestFlexAWSUnion{
+				&TestFlexAWSUnionMemberS3Uri{Value: "s3://bucket/key"},
+				&TestFlexAWSUnionMemberInlineText{Value: "text"},
+			}},
+			Target: &TestFlexTF27{},
+			WantTarget: &TestFlexTF27{Sources: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []TestFlexTFUnion01{
+				{
+					S3URI:         types.StringValue("s3://bucket/key"),
+					Configuration: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					Text:          types.StringNull(),
+				},
+				{
+					S3URI:         types.StringNull(),
+					Configuration: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+					Text:          types.StringValue("text"),
+				},
+			})},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestFlattenAutoFlexTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "name, omitempty and ignore",
+			Source: &TestFlexAWS29{
+				Id:          aws.String("id"),
+				Identifier:  aws.String("identifier"),
+				Description: aws.String(""),
+				Name:        aws.String("name"),
+			},
+			Target: &TestFlexTF29{},
+			WantTarget: &TestFlexTF29{
+				Identifier:  types.StringValue("id"),
+				Description: types.StringNull(),
+			},
+		},
+		{
+			TestName: "omitempty with value",
+			Source: &TestFlexAWS29{
+				Description: aws.String("description"),
+			},
+			Target: &TestFlexTF29{},
+			WantTarget: &TestFlexTF29{
+				Identifier:  types.StringNull(),
+				Description: types.StringValue("description"),
+			},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestFlattenTimeAndDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testTimeStr := "2013-09-25T09:34:01Z"
+	testTimeTime := errs.Must(time.Parse(time.RFC3339, testTimeStr))
+
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "*time.Time to types.String",
+			Source:     &TestFlexTimeAWS01{CreationDateTime: &testTimeTime},
+			Target:     &TestFlexTimeTF02{},
+			WantTarget: &TestFlexTimeTF02{CreationDateTime: types.StringValue(testTimeStr)},
+		},
+		{
+			TestName:   "nil *time.Time to types.String",
+			Source:     &TestFlexTimeAWS01{},
+			Target:     &TestFlexTimeTF02{},
+			WantTarget: &TestFlexTimeTF02{CreationDateTime: types.StringNull()},
+		},
+		{
+			TestName:   "time.Time to types.String",
+			Source:     &TestFlexTimeAWS02{CreationDateTime: testTimeTime},
+			Target:     &TestFlexTimeTF02{},
+			WantTarget: &TestFlexTimeTF02{CreationDateTime: types.StringValue(testTimeStr)},
+		},
+		{
+			TestName:   "nil document",
+			Source:     &TestFlexAWS19{},
+			Target:     &TestFlexTF19{},
+			WantTarget: &TestFlexTF19{Field1: types.StringNull()},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
 func runAutoFlattenTestCases(ctx context.Context, t *testing.T, testCases autoFlexTestCases) {
 	t.Helper()
 
@@ -10,7 +10,9 @@ import (
 	"strings"
 
 	pluralize "github.com/gertd/go-pluralize"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 type ResourcePrefixCtxKey string
@@ -35,6 +37,9 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// unionMembers stores the member types of Smithy union interface types
+	unionMembers map[reflect.Type][]reflect.Type
 }
 
 // IsIgnoredField returns true if s is in the list of ignored field names
@@ -66,9 +71,80 @@ var (
 	}
 )
 
+// AddUnionMembers appends members to the list of member types of the Smithy union type t
+func (o *AutoFlexOptions) AddUnionMembers(t reflect.Type, members ...reflect.Type) {
+	if o.unionMembers == nil {
+		o.unionMembers = make(map[reflect.Type][]reflect.Type)
+	}
+	o.unionMembers[t] = append(o.unionMembers[t], members...)
+}
+
+// UnionMembers returns the member types of the Smithy union type t
+func (o *AutoFlexOptions) UnionMembers(t reflect.Type) []reflect.Type {
+	return o.unionMembers[t]
+}
+
 // AutoFlexOptionsFunc is a type alias for an autoFlexer functional option.
 type AutoFlexOptionsFunc func(*AutoFlexOptions)
 
+// WithUnionMembers registers the members of the Smithy union type T.
+// A union is expanded from an Object, or a List or Set of Objects, with one field per member,
+// only one of which may be set. The members must be registered so that the expander
+// can create them, for example
+//
+//	WithUnionMembers[awstypes.Source](&awstypes.SourceMemberS3Uri{}, &awstypes.SourceMemberConfiguration{})
+//
+// Flattening a union does not require its members to be registered.
+func WithUnionMembers[T any](members ...T) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		t := reflect.TypeFor[T]()
+		for _, member := range members {
+			o.AddUnionMembers(t, reflect.TypeOf(member))
+		}
+	}
+}
+
+const (
+	autoFlexTagKey = "autoflex"
+)
+
+// autoFlexTag is a parsed `autoflex` struct field tag.
+//
+// `autoflex:"name=Foo"` maps the field to the field (or union member) named Foo instead of fuzzy matching names.
+// `autoflex:",omitempty"` expands empty values to the zero value (e.g. nil instead of a pointer to "")
+// and flattens empty values to null.
+// `autoflex:"-"` ignores the field.
+type autoFlexTag struct {
+	ignore    bool
+	name      string
+	omitEmpty bool
+}
+
+func parseAutoFlexTag(field reflect.StructField) autoFlexTag {
+	var tag autoFlexTag
+
+	v, ok := field.Tag.Lookup(autoFlexTagKey)
+	if !ok {
+		return tag
+	}
+
+	if v == "-" {
+		tag.ignore = true
+		return tag
+	}
+
+	for _, option := range strings.Split(v, ",") {
+		switch k, v, _ := strings.Cut(option, "="); k {
+		case "name":
+			tag.name = v
+		case "omitempty":
+			tag.omitEmpty = true
+		}
+	}
+
+	return tag
+}
+
 // autoFlexConvert converts `from` to `to` using the specified auto-flexer.
 func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -136,11 +212,26 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 		if fieldName == MapBlockKey {
 			continue
 		}
+		tagFrom := parseAutoFlexTag(field)
+		if tagFrom.ignore {
+			continue
+		}
 
-		toFieldVal := findFieldFuzzy(ctx, fieldName, valTo, valFrom, flexer)
-		if !toFieldVal.IsValid() {
+		var toField reflect.StructField
+		var ok bool
+		if tagFrom.name != "" {
+			toField, ok = valTo.Type().FieldByName(tagFrom.name)
+		} else {
+			toField, ok = findFieldFuzzy(ctx, fieldName, valTo, valFrom, flexer)
+		}
+		if !ok {
 			continue // Corresponding field not found in to.
 		}
+		tagTo := parseAutoFlexTag(toField)
+		if tagTo.ignore {
+			continue
+		}
+		toFieldVal := valTo.FieldByIndex(toField.Index)
 		if !toFieldVal.CanSet() {
 			continue // Corresponding field value can't be changed.
 		}
@@ -150,15 +241,93 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", fieldName))
 			return diags
 		}
+
+		if tagFrom.omitEmpty || tagTo.omitEmpty {
+			diags.Append(omitEmpty(ctx, valFrom.Field(i), toFieldVal)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
 	}
 
 	return diags
 }
 
-func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value, flexer autoFlexer) reflect.Value {
-	// first precedence is exact match (case sensitive)
-	if v := valTo.FieldByName(fieldNameFrom); v.IsValid() {
-		return v
+// omitEmpty replaces a converted field value if the AWS API value is empty.
+// An expanded empty value is replaced by the target's zero value and a flattened empty value by null.
+func omitEmpty(ctx context.Context, vFrom, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Flatten.
+	if _, ok := vTo.Interface().(attr.Value); ok {
+		if isEmptyValue(vFrom) {
+			diags.Append(setNull(ctx, vTo)...)
+		}
+
+		return diags
+	}
+
+	// Expand.
+	if isEmptyValue(vTo) {
+		vTo.SetZero()
+	}
+
+	return diags
+}
+
+// isEmptyValue returns whether v is false, 0, an empty string or collection, a zero struct, nil,
+// or a pointer to or interface holding an empty value.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil() || isEmptyValue(v.Elem())
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	}
+
+	return v.IsZero()
+}
+
+// setNull sets the Plugin Framework value vTo to the null value of its type.
+func setNull(ctx context.Context, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, ok := vTo.Interface().(attr.Value)
+	if !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("does not implement attr.Value: %s", vTo.Kind()))
+		return diags
+	}
+
+	t := v.Type(ctx)
+	null, err := t.ValueFromTerraform(ctx, tftypes.NewValue(t.TerraformType(ctx), nil))
+	if err != nil {
+		diags.AddError("AutoFlEx", err.Error())
+		return diags
+	}
+
+	if t := reflect.TypeOf(null); !t.AssignableTo(vTo.Type()) {
+		diags.AddError("AutoFlEx", fmt.Sprintf("null value (%s) not assignable to %s", t, vTo.Type()))
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(null))
+
+	return diags
+}
+
+func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value, flexer autoFlexer) (reflect.StructField, bool) {
+	typTo := valTo.Type()
+
+	// first precedence is an explicit mapping
+	for i := 0; i < typTo.NumField(); i++ {
+		if field := typTo.Field(i); field.PkgPath == "" && parseAutoFlexTag(field).name == fieldNameFrom {
+			return field, true
+		}
+	}
+
+	// second precedence is exact match (case sensitive)
+	if field, ok := fieldByName(typTo, fieldNameFrom); ok {
+		return field, true
 	}
 
 	// If a "from" field fuzzy matches a "to" field, we are certain the fuzzy match
@@ -167,9 +336,9 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 	// fuzzy match "Value" in "to" since "from" also has "Value". We check "from"
 	// to make sure fuzzy matches are not in "from".
 
-	// second precedence is exact match (case insensitive)
+	// third precedence is exact match (case insensitive)
 	opts := flexer.getOptions()
-	for i, typTo := 0, valTo.Type(); i < typTo.NumField(); i++ {
+	for i := 0; i < typTo.NumField(); i++ {
 		field := typTo.Field(i)
 		if field.PkgPath != "" {
 			continue // Skip unexported fields.
@@ -178,26 +347,25 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 		if opts.IsIgnoredField(fieldNameTo) {
 			continue
 		}
-		if v := valTo.FieldByName(fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
-			// probably could assume validity here since reflect gave the field name
-			return v
+		if field, ok := fieldByName(typTo, fieldNameTo); ok && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
+			return field, true
 		}
 	}
 
-	// third precedence is singular/plural
+	// fourth precedence is singular/plural
 	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(plural.Plural(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Plural(fieldNameFrom)); v.IsValid() {
-			return v
+		if field, ok := fieldByName(typTo, plural.Plural(fieldNameFrom)); ok {
+			return field, true
 		}
 	}
 
 	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(plural.Singular(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Singular(fieldNameFrom)); v.IsValid() {
-			return v
+		if field, ok := fieldByName(typTo, plural.Singular(fieldNameFrom)); ok {
+			return field, true
 		}
 	}
 
-	// fourth precedence is using resource prefix
+	// fifth precedence is using resource prefix
 	if v, ok := ctx.Value(ResourcePrefix).(string); ok && v != "" {
 		v = strings.ReplaceAll(v, " ", "")
 		if ctx.Value(ResourcePrefixRecurse) == nil {
@@ -210,8 +378,23 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 		}
 	}
 
-	// no finds, fuzzy or otherwise - return zero value
-	return valTo.FieldByName(fieldNameFrom)
+	// no finds, fuzzy or otherwise
+	return reflect.StructField{}, false
+}
+
+// fieldByName returns the struct field with the given name.
+// Fields whose `autoflex` tag ignores them or maps them to another name are never matched by name.
+func fieldByName(typ reflect.Type, name string) (reflect.StructField, bool) {
+	field, ok := typ.FieldByName(name)
+	if !ok {
+		return reflect.StructField{}, false
+	}
+
+	if tag := parseAutoFlexTag(field); tag.ignore || tag.name != "" {
+		return reflect.StructField{}, false
+	}
+
+	return field, true
 }
 
 func fieldExistsInStruct(field string, str reflect.Value) bool {
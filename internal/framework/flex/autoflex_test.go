@@ -361,3 +361,88 @@ type TestFlexAWS21 struct {
 type TestFlexAWS22 struct {
 	Field1 map[string]map[string]*string
 }
+
+type TestFlexTimeTF02 struct {
+	CreationDateTime types.String `tfsdk:"creation_date_time"`
+}
+
+// TestFlexDocument is a named document interface, like the AWS SDK's document.Interface.
+type TestFlexDocument interface {
+	smithyjson.JSONStringer
+}
+
+func newTestFlexDocument(v any) TestFlexDocument {
+	return &testJSONDocument{Value: v}
+}
+
+type TestFlexTF25 struct {
+	Field1 fwtypes.SmithyJSON[TestFlexDocument] `tfsdk:"field1"`
+}
+
+type TestFlexAWS25 struct {
+	Field1 TestFlexDocument
+}
+
+// TestFlexAWSUnion is a Smithy union.
+type TestFlexAWSUnion interface {
+	isTestFlexAWSUnion()
+}
+
+type TestFlexAWSUnionMemberS3Uri struct {
+	Value string
+}
+
+func (*TestFlexAWSUnionMemberS3Uri) isTestFlexAWSUnion() {}
+
+type TestFlexAWSUnionMemberConfiguration struct {
+	Value TestFlexAWS01
+}
+
+func (*TestFlexAWSUnionMemberConfiguration) isTestFlexAWSUnion() {}
+
+type TestFlexAWSUnionMemberInlineText struct {
+	Value string
+}
+
+func (*TestFlexAWSUnionMemberInlineText) isTestFlexAWSUnion() {}
+
+// TestFlexTFUnion01 has one field per union member.
+type TestFlexTFUnion01 struct {
+	S3URI         types.String                                  `tfsdk:"s3_uri"`
+	Configuration fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"configuration"`
+	Text          types.String                                  `tfsdk:"text" autoflex:"name=InlineText"`
+}
+
+type TestFlexTF26 struct {
+	Source fwtypes.ListNestedObjectValueOf[TestFlexTFUnion01] `tfsdk:"source"`
+}
+
+type TestFlexAWS26 struct {
+	Source TestFlexAWSUnion
+}
+
+type TestFlexTF27 struct {
+	Sources fwtypes.ListNestedObjectValueOf[TestFlexTFUnion01] `tfsdk:"sources"`
+}
+
+type TestFlexAWS27 struct {
+	Sources []TestFlexAWSUnion
+}
+
+type TestFlexTF28 struct {
+	Source fwtypes.ObjectValueOf[TestFlexTFUnion01] `tfsdk:"source"`
+}
+
+// `autoflex` struct tags.
+type TestFlexTF29 struct {
+	Identifier  types.String `tfsdk:"identifier" autoflex:"name=Id"`
+	Description types.String `tfsdk:"description" autoflex:",omitempty"`
+	Name        types.String `tfsdk:"name" autoflex:"-"`
+}
+
+type TestFlexAWS29 struct {
+	Id          *string
+	Identifier  *string
+	Description *string
+	Name        *string
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// providerAddressSuffix is the suffix of this provider's source address, e.g. "registry.terraform.io/hashicorp/aws".
+const providerAddressSuffix = "hashicorp/aws"
+
+// MoveStateFrom returns a state mover that moves the state of another of this provider's resource types to the target resource,
+// enabling `moved` blocks whose `from` is a resource of that type.
+// sourceSchema is the source resource's schema, including its version, and need only declare the attributes used by f.
+// Requests to move state from other resource types, schema versions or providers are ignored so that other state movers can handle them.
+// See https://developer.hashicorp.com/terraform/plugin/framework/resources/state-move.
+func MoveStateFrom(sourceTypeName string, sourceSchema *schema.Schema, f func(context.Context, resource.MoveStateRequest, *resource.MoveStateResponse)) resource.StateMover {
+	return resource.StateMover{
+		SourceSchema: sourceSchema,
+		StateMover: func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+			if request.SourceTypeName != sourceTypeName {
+				return
+			}
+
+			if request.SourceSchemaVersion != sourceSchema.Version {
+				return
+			}
+
+			if !strings.HasSuffix(request.SourceProviderAddress, providerAddressSuffix) {
+				return
+			}
+
+			if request.SourceState == nil {
+				response.Diagnostics.AddError(
+					"Unable to Move Resource State",
+					fmt.Sprintf("The state of the source %s resource could not be read. Refresh the source resource's state using the current provider version and try again.", sourceTypeName),
+				)
+
+				return
+			}
+
+			f(ctx, request, response)
+		},
+	}
+}
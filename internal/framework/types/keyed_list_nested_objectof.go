@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+var (
+	_ basetypes.ListTypable                    = (*keyedListNestedObjectTypeOf[IdentifiableObject])(nil)
+	_ NestedObjectCollectionType               = (*keyedListNestedObjectTypeOf[IdentifiableObject])(nil)
+	_ basetypes.ListValuable                   = (*KeyedListNestedObjectValueOf[IdentifiableObject])(nil)
+	_ basetypes.ListValuableWithSemanticEquals = (*KeyedListNestedObjectValueOf[IdentifiableObject])(nil)
+	_ NestedObjectCollectionValue              = (*KeyedListNestedObjectValueOf[IdentifiableObject])(nil)
+)
+
+// IdentifiableObject is implemented by nested object models that declare an identity attribute.
+// The identity attribute's value uniquely identifies an object within a collection.
+type IdentifiableObject interface {
+	// IdentityAttribute returns the name (`tfsdk` tag) of the identity attribute.
+	IdentityAttribute() string
+}
+
+// A keyed list is a list of nested objects whose elements are matched by identity attribute value
+// with insertion order not significant for semantic equality.
+// This allows configurations to keep their ordering without spurious updates when the API reorders elements,
+// replacing the SDKv2 pattern of a schema.TypeSet with a custom hash function.
+
+// keyedListNestedObjectTypeOf is the attribute type of a KeyedListNestedObjectValueOf.
+type keyedListNestedObjectTypeOf[T IdentifiableObject] struct {
+	basetypes.ListType
+}
+
+func NewKeyedListNestedObjectTypeOf[T IdentifiableObject](ctx context.Context) keyedListNestedObjectTypeOf[T] {
+	return keyedListNestedObjectTypeOf[T]{basetypes.ListType{ElemType: NewObjectTypeOf[T](ctx)}}
+}
+
+func (t keyedListNestedObjectTypeOf[T]) Equal(o attr.Type) bool {
+	other, ok := o.(keyedListNestedObjectTypeOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return t.ListType.Equal(other.ListType)
+}
+
+func (t keyedListNestedObjectTypeOf[T]) String() string {
+	var zero T
+	return fmt.Sprintf("KeyedListNestedObjectTypeOf[%T]", zero)
+}
+
+func (t keyedListNestedObjectTypeOf[T]) ValueFromList(ctx context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return NewKeyedListNestedObjectValueOfNull[T](ctx), diags
+	}
+	if in.IsUnknown() {
+		return NewKeyedListNestedObjectValueOfUnknown[T](ctx), diags
+	}
+
+	typ, d := newObjectTypeOf[T](ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return NewKeyedListNestedObjectValueOfUnknown[T](ctx), diags
+	}
+
+	v, d := basetypes.NewListValue(typ, in.Elements())
+	diags.Append(d...)
+	if diags.HasError() {
+		return NewKeyedListNestedObjectValueOfUnknown[T](ctx), diags
+	}
+
+	return KeyedListNestedObjectValueOf[T]{ListValue: v}, diags
+}
+
+func (t keyedListNestedObjectTypeOf[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ListType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	listValue, ok := attrValue.(basetypes.ListValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	listValuable, diags := t.ValueFromList(ctx, listValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting ListValue to ListValuable: %v", diags)
+	}
+
+	return listValuable, nil
+}
+
+func (t keyedListNestedObjectTypeOf[T]) ValueType(ctx context.Context) attr.Value {
+	return KeyedListNestedObjectValueOf[T]{}
+}
+
+func (t keyedListNestedObjectTypeOf[T]) NewObjectPtr(ctx context.Context) (any, diag.Diagnostics) {
+	return objectTypeNewObjectPtr[T](ctx)
+}
+
+func (t keyedListNestedObjectTypeOf[T]) NewObjectSlice(ctx context.Context, len, cap int) (any, diag.Diagnostics) {
+	return nestedObjectTypeNewObjectSlice[T](ctx, len, cap)
+}
+
+func (t keyedListNestedObjectTypeOf[T]) NullValue(ctx context.Context) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	return NewKeyedListNestedObjectValueOfNull[T](ctx), diags
+}
+
+func (t keyedListNestedObjectTypeOf[T]) ValueFromObjectPtr(ctx context.Context, ptr any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v, ok := ptr.(*T); ok {
+		v, d := NewKeyedListNestedObjectValueOfPtr(ctx, v)
+		diags.Append(d...)
+		return v, d
+	}
+
+	diags.Append(diag.NewErrorDiagnostic("Invalid pointer value", fmt.Sprintf("incorrect type: want %T, got %T", (*T)(nil), ptr)))
+	return nil, diags
+}
+
+func (t keyedListNestedObjectTypeOf[T]) ValueFromObjectSlice(ctx context.Context, slice any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v, ok := slice.([]*T); ok {
+		v, d := NewKeyedListNestedObjectValueOfSlice(ctx, v)
+		diags.Append(d...)
+		return v, d
+	}
+
+	diags.Append(diag.NewErrorDiagnostic("Invalid slice value", fmt.Sprintf("incorrect type: want %T, got %T", (*[]T)(nil), slice)))
+	return nil, diags
+}
+
+// KeyedListNestedObjectValueOf represents a Terraform Plugin Framework List value whose elements are of type `ObjectTypeOf[T]`
+// and are matched by the value of T's identity attribute for semantic equality.
+type KeyedListNestedObjectValueOf[T IdentifiableObject] struct {
+	basetypes.ListValue
+}
+
+func (v KeyedListNestedObjectValueOf[T]) Equal(o attr.Value) bool {
+	other, ok := o.(KeyedListNestedObjectValueOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.ListValue.Equal(other.ListValue)
+}
+
+func (v KeyedListNestedObjectValueOf[T]) Type(ctx context.Context) attr.Type {
+	return NewKeyedListNestedObjectTypeOf[T](ctx)
+}
+
+// ListSemanticEquals returns true if both lists contain the same objects, ignoring order.
+// Objects are paired by the value of their identity attribute and paired objects must be equal.
+// Lists containing objects with null, unknown or duplicate identities are never semantically equal.
+func (v KeyedListNestedObjectValueOf[T]) ListSemanticEquals(ctx context.Context, newValuable basetypes.ListValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(KeyedListNestedObjectValueOf[T])
+	if !ok {
+		return false, diags
+	}
+
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return false, diags
+	}
+
+	var zero T
+	name := zero.IdentityAttribute()
+
+	oldElems, newElems := v.Elements(), newValue.Elements()
+
+	if len(oldElems) != len(newElems) {
+		return false, diags
+	}
+
+	oldKeys, d := identityAttributeValues(ctx, oldElems, name)
+	diags.Append(d...)
+	if diags.HasError() || oldKeys == nil {
+		return false, diags
+	}
+
+	newKeys, d := identityAttributeValues(ctx, newElems, name)
+	diags.Append(d...)
+	if diags.HasError() || newKeys == nil {
+		return false, diags
+	}
+
+	for i, newElem := range newElems {
+		j := slices.IndexFunc(oldKeys, newKeys[i].Equal)
+		if j < 0 || !oldElems[j].Equal(newElem) {
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
+
+func (v KeyedListNestedObjectValueOf[T]) ToObjectPtr(ctx context.Context) (any, diag.Diagnostics) {
+	return v.ToPtr(ctx)
+}
+
+func (v KeyedListNestedObjectValueOf[T]) ToObjectSlice(ctx context.Context) (any, diag.Diagnostics) {
+	return v.ToSlice(ctx)
+}
+
+// ToPtr returns a pointer to the single element of a KeyedListNestedObject.
+func (v KeyedListNestedObjectValueOf[T]) ToPtr(ctx context.Context) (*T, diag.Diagnostics) {
+	return nestedObjectValueObjectPtr[T](ctx, v.ListValue)
+}
+
+// ToSlice returns a slice of pointers to the elements of a KeyedListNestedObject.
+func (v KeyedListNestedObjectValueOf[T]) ToSlice(ctx context.Context) ([]*T, diag.Diagnostics) {
+	return nestedObjectValueObjectSlice[T](ctx, v.ListValue)
+}
+
+// identityAttributeValues returns the values of the named attribute of each object element.
+// A nil slice is returned if any value is null or unknown, or if any value is repeated.
+func identityAttributeValues(ctx context.Context, elements []attr.Value, name string) ([]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	keys := make([]attr.Value, 0, len(elements))
+	for _, element := range elements {
+		objectValuable, ok := element.(basetypes.ObjectValuable)
+		if !ok {
+			diags.Append(diag.NewErrorDiagnostic("Invalid list element", fmt.Sprintf("incorrect type: want object, got %T", element)))
+			return nil, diags
+		}
+
+		object, d := objectValuable.ToObjectValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		key, ok := object.Attributes()[name]
+		if !ok {
+			diags.Append(diag.NewErrorDiagnostic("Invalid identity attribute", fmt.Sprintf("attribute %q not found", name)))
+			return nil, diags
+		}
+
+		if key.IsNull() || key.IsUnknown() || slices.ContainsFunc(keys, key.Equal) {
+			return nil, diags
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, diags
+}
+
+func NewKeyedListNestedObjectValueOfNull[T IdentifiableObject](ctx context.Context) KeyedListNestedObjectValueOf[T] {
+	return KeyedListNestedObjectValueOf[T]{ListValue: basetypes.NewListNull(NewObjectTypeOf[T](ctx))}
+}
+
+func NewKeyedListNestedObjectValueOfUnknown[T IdentifiableObject](ctx context.Context) KeyedListNestedObjectValueOf[T] {
+	return KeyedListNestedObjectValueOf[T]{ListValue: basetypes.NewListUnknown(NewObjectTypeOf[T](ctx))}
+}
+
+func NewKeyedListNestedObjectValueOfPtr[T IdentifiableObject](ctx context.Context, t *T) (KeyedListNestedObjectValueOf[T], diag.Diagnostics) {
+	return NewKeyedListNestedObjectValueOfSlice(ctx, []*T{t})
+}
+
+func NewKeyedListNestedObjectValueOfPtrMust[T IdentifiableObject](ctx context.Context, t *T) KeyedListNestedObjectValueOf[T] {
+	return fwdiag.Must(NewKeyedListNestedObjectValueOfPtr(ctx, t))
+}
+
+func NewKeyedListNestedObjectValueOfSlice[T IdentifiableObject](ctx context.Context, ts []*T) (KeyedListNestedObjectValueOf[T], diag.Diagnostics) {
+	return newKeyedListNestedObjectValueOf[T](ctx, ts)
+}
+
+func NewKeyedListNestedObjectValueOfSliceMust[T IdentifiableObject](ctx context.Context, ts []*T) KeyedListNestedObjectValueOf[T] {
+	return fwdiag.Must(NewKeyedListNestedObjectValueOfSlice(ctx, ts))
+}
+
+func NewKeyedListNestedObjectValueOfValueSlice[T IdentifiableObject](ctx context.Context, ts []T) (KeyedListNestedObjectValueOf[T], diag.Diagnostics) {
+	return newKeyedListNestedObjectValueOf[T](ctx, ts)
+}
+
+func NewKeyedListNestedObjectValueOfValueSliceMust[T IdentifiableObject](ctx context.Context, ts []T) KeyedListNestedObjectValueOf[T] {
+	return fwdiag.Must(NewKeyedListNestedObjectValueOfValueSlice(ctx, ts))
+}
+
+func newKeyedListNestedObjectValueOf[T IdentifiableObject](ctx context.Context, elements any) (KeyedListNestedObjectValueOf[T], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	typ, d := newObjectTypeOf[T](ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return NewKeyedListNestedObjectValueOfUnknown[T](ctx), diags
+	}
+
+	v, d := basetypes.NewListValueFrom(ctx, typ, elements)
+	diags.Append(d...)
+	if diags.HasError() {
+		return NewKeyedListNestedObjectValueOfUnknown[T](ctx), diags
+	}
+
+	return KeyedListNestedObjectValueOf[T]{ListValue: v}, diags
+}
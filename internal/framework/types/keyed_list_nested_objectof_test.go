@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type RuleA struct {
+	Action     types.String `tfsdk:"action"`
+	RuleNumber types.Int64  `tfsdk:"rule_number"`
+}
+
+func (RuleA) IdentityAttribute() string {
+	return "rule_number"
+}
+
+func TestKeyedListNestedObjectTypeOfValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	ruleA := RuleA{
+		Action:     types.StringValue("allow"),
+		RuleNumber: types.Int64Value(100),
+	}
+	ruleAType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"action":      tftypes.String,
+			"rule_number": tftypes.Number,
+		},
+	}
+	ruleAListType := tftypes.List{ElementType: ruleAType}
+	ruleAValue := tftypes.NewValue(ruleAType, map[string]tftypes.Value{
+		"action":      tftypes.NewValue(tftypes.String, "allow"),
+		"rule_number": tftypes.NewValue(tftypes.Number, 100),
+	})
+	ruleAListValue := tftypes.NewValue(ruleAListType, []tftypes.Value{ruleAValue})
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		tfVal   tftypes.Value
+		wantVal attr.Value
+	}{
+		"null value": {
+			tfVal:   tftypes.NewValue(ruleAListType, nil),
+			wantVal: fwtypes.NewKeyedListNestedObjectValueOfNull[RuleA](ctx),
+		},
+		"unknown value": {
+			tfVal:   tftypes.NewValue(ruleAListType, tftypes.UnknownValue),
+			wantVal: fwtypes.NewKeyedListNestedObjectValueOfUnknown[RuleA](ctx),
+		},
+		"valid value": {
+			tfVal:   ruleAListValue,
+			wantVal: fwtypes.NewKeyedListNestedObjectValueOfPtrMust(ctx, &ruleA),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotVal, err := fwtypes.NewKeyedListNestedObjectTypeOf[RuleA](ctx).ValueFromTerraform(ctx, testCase.tfVal)
+
+			if err != nil {
+				t.Fatalf("err = %q", err)
+			}
+
+			if diff := cmp.Diff(gotVal, testCase.wantVal); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestKeyedListNestedObjectValueOfListSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rule := func(number int64, action string) RuleA {
+		return RuleA{
+			Action:     types.StringValue(action),
+			RuleNumber: types.Int64Value(number),
+		}
+	}
+	rules := func(rules ...RuleA) fwtypes.KeyedListNestedObjectValueOf[RuleA] {
+		return fwtypes.NewKeyedListNestedObjectValueOfValueSliceMust(ctx, append([]RuleA{}, rules...))
+	}
+
+	type testCase struct {
+		val1, val2 fwtypes.KeyedListNestedObjectValueOf[RuleA]
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"both empty": {
+			val1:   rules(),
+			val2:   rules(),
+			equals: true,
+		},
+		"first empty, second single element": {
+			val1:   rules(),
+			val2:   rules(rule(100, "allow")),
+			equals: false,
+		},
+		"same order": {
+			val1:   rules(rule(100, "allow"), rule(200, "deny")),
+			val2:   rules(rule(100, "allow"), rule(200, "deny")),
+			equals: true,
+		},
+		"reordered": {
+			val1:   rules(rule(100, "allow"), rule(200, "deny"), rule(300, "allow")),
+			val2:   rules(rule(300, "allow"), rule(100, "allow"), rule(200, "deny")),
+			equals: true,
+		},
+		"reordered, attribute changed": {
+			val1:   rules(rule(100, "allow"), rule(200, "deny")),
+			val2:   rules(rule(200, "allow"), rule(100, "allow")),
+			equals: false,
+		},
+		"reordered, identity changed": {
+			val1:   rules(rule(100, "allow"), rule(200, "deny")),
+			val2:   rules(rule(300, "deny"), rule(100, "allow")),
+			equals: false,
+		},
+		"different lengths": {
+			val1:   rules(rule(100, "allow"), rule(200, "deny")),
+			val2:   rules(rule(200, "deny"), rule(100, "allow"), rule(300, "deny")),
+			equals: false,
+		},
+		"duplicate identity": {
+			val1:   rules(rule(100, "allow"), rule(100, "deny")),
+			val2:   rules(rule(100, "deny"), rule(100, "allow")),
+			equals: false,
+		},
+		"null identity": {
+			val1: rules(rule(100, "allow"), RuleA{Action: types.StringValue("deny"), RuleNumber: types.Int64Null()}),
+			val2: rules(RuleA{Action: types.StringValue("deny"), RuleNumber: types.Int64Null()}, rule(100, "allow")),
+		},
+		"null": {
+			val1:   fwtypes.NewKeyedListNestedObjectValueOfNull[RuleA](ctx),
+			val2:   rules(),
+			equals: false,
+		},
+		"unknown": {
+			val1:   fwtypes.NewKeyedListNestedObjectValueOfUnknown[RuleA](ctx),
+			val2:   rules(rule(100, "allow")),
+			equals: false,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			equals, diags := test.val1.ListSemanticEquals(ctx, test.val2)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("ListSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
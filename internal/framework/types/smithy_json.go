@@ -141,6 +141,11 @@ func (v SmithyJSON[T]) ValueInterface() (T, diag.Diagnostics) {
 	return v.f(data), diags
 }
 
+// ValueSmithyDocument returns the value as a Smithy document, regardless of the document's Go type.
+func (v SmithyJSON[T]) ValueSmithyDocument() (any, diag.Diagnostics) {
+	return v.ValueInterface()
+}
+
 func (v SmithyJSON[T]) Type(context.Context) attr.Type {
 	return SmithyJSONType[T]{}
 }
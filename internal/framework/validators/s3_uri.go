@@ -11,6 +11,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+var s3URIRegexp = regexache.MustCompile(`^s3://[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9](/.*)?$`)
+
+// IsS3URI returns whether the specified string is a valid S3 URI (s3://bucket[/key]).
+func IsS3URI(s string) bool {
+	return s3URIRegexp.MatchString(s)
+}
+
 // s3URIValidator validates that a string Attribute's value is a valid S3 URI.
 type s3URIValidator struct{}
 
@@ -27,7 +34,7 @@ func (validator s3URIValidator) ValidateString(ctx context.Context, request vali
 		return
 	}
 
-	if !regexache.MustCompile(`^s3://[a-z0-9][\.\-a-z0-9]{1,61}[a-z0-9](/.*)?$`).MatchString(request.ConfigValue.ValueString()) {
+	if !IsS3URI(request.ConfigValue.ValueString()) {
 		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
 			request.Path,
 			validator.Description(ctx),
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	// arnSections is the number of colon-separated sections in an ARN.
+	arnSections = 6
+)
+
+var _ function.Function = arnMatchesFunction{}
+
+func NewARNMatchesFunction() function.Function {
+	return &arnMatchesFunction{}
+}
+
+type arnMatchesFunction struct{}
+
+func (f arnMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_matches"
+}
+
+func (f arnMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_matches Function",
+		MarkdownDescription: "Checks whether an ARN matches an ARN pattern using the same semantics as IAM policy `Resource` " +
+			"elements. `*` matches any sequence of characters and `?` matches any single character. " +
+			"Wildcards do not match across the colons separating the sections of an ARN, except in the resource section.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, which may contain `*` and `?` wildcards",
+			},
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to match",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, arn string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &arn))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := arnMatches(pattern, arn)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// arnMatches reports whether an ARN matches an IAM-style ARN pattern.
+func arnMatches(pattern, arn string) (bool, error) {
+	arnParts := strings.SplitN(arn, ":", arnSections)
+	if len(arnParts) != arnSections || arnParts[0] != "arn" {
+		return false, errors.New("arn: invalid prefix")
+	}
+
+	if pattern == "*" {
+		return true, nil
+	}
+
+	patternParts := strings.SplitN(pattern, ":", arnSections)
+	if len(patternParts) != arnSections || patternParts[0] != "arn" {
+		return false, errors.New("pattern must be an ARN or \"*\"")
+	}
+
+	for i := range patternParts {
+		if !wildcardMatch(patternParts[i], arnParts[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// wildcardMatch reports whether s matches pattern, where `*` matches any sequence of characters
+// (including none) and `?` matches any single character.
+func wildcardMatch(pattern, s string) bool {
+	p, q := []rune(pattern), []rune(s)
+	i, j := 0, 0
+	// Position of the last `*` in the pattern and the position in s from which it is matched.
+	star, match := -1, 0
+
+	for j < len(q) {
+		switch {
+		case i < len(p) && (p[i] == '?' || p[i] == q[j]):
+			i++
+			j++
+		case i < len(p) && p[i] == '*':
+			star, match = i, j
+			i++
+		case star != -1:
+			// Backtrack, letting the last `*` match one more character.
+			match++
+			i, j = star+1, match
+		default:
+			return false
+		}
+	}
+
+	for i < len(p) && p[i] == '*' {
+		i++
+	}
+
+	return i == len(p)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestARNMatchesFunction_matches(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:s3:::example-*/logs/*", "arn:aws:s3:::example-bucket/logs/2024/01/app.log"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNMatchesFunction_matchesSingleCharacter(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:iam::44445555666?:role/example", "arn:aws:iam::444455556666:role/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNMatchesFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchesFunctionConfig("arn:aws:ec2:*:111122223333:vpc/*", "arn:aws:ec2:us-west-2:444455556666:vpc/vpc-12345678"), //lintignore:AWSAT003
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNMatchesFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNMatchesFunctionConfig("arn:aws:ec2:*:vpc/*", "arn:aws:ec2:us-west-2:444455556666:vpc/vpc-12345678"), //lintignore:AWSAT003
+				ExpectError: regexache.MustCompile(`pattern[\s\n]*must[\s\n]*be[\s\n]*an[\s\n]*ARN`),
+			},
+			{
+				Config:      testARNMatchesFunctionConfig("arn:aws:iam::*:role/*", "invalid"),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*prefix`),
+			},
+			{
+				Config:      testARNMatchesFunctionConfig("*", "invalid"),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*prefix`),
+			},
+		},
+	})
+}
+
+func testARNMatchesFunctionConfig(pattern, arn string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::arn_matches(%[1]q, %[2]q)
+}
+`, pattern, arn)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// VPC and subnet CIDR block size limits.
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html.
+	ipv4VPCMinPrefixLength    = 16
+	ipv4SubnetMaxPrefixLength = 28
+	ipv6VPCMinPrefixLength    = 44
+	ipv6SubnetPrefixLength    = 64
+)
+
+var _ function.Function = cidrSplitSubnetsFunction{}
+
+func NewCIDRSplitSubnetsFunction() function.Function {
+	return &cidrSplitSubnetsFunction{}
+}
+
+type cidrSplitSubnetsFunction struct{}
+
+func (f cidrSplitSubnetsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_split_subnets"
+}
+
+func (f cidrSplitSubnetsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_split_subnets Function",
+		MarkdownDescription: "Splits a VPC CIDR block into the specified number of equally sized subnet CIDR blocks, " +
+			"each as large as possible. IPv4 subnets are no smaller than /28 and IPv6 subnets are always /64.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "VPC IPv4 or IPv6 CIDR block",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: "Number of subnets",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrSplitSubnetsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := cidrSplitSubnets(cidr, count)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func cidrSplitSubnets(cidr string, count int64) ([]string, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	if prefix != prefix.Masked() {
+		return nil, fmt.Errorf("%s is not a network address, did you mean %s?", cidr, prefix.Masked())
+	}
+
+	if count < 1 {
+		return nil, fmt.Errorf("count must be at least 1")
+	}
+
+	// Number of additional prefix bits needed for count subnets.
+	newBits := bits.Len64(uint64(count - 1))
+	prefixLength := prefix.Bits() + newBits
+
+	if prefix.Addr().Is4() {
+		if prefix.Bits() < ipv4VPCMinPrefixLength || prefix.Bits() > ipv4SubnetMaxPrefixLength {
+			return nil, fmt.Errorf("IPv4 VPC CIDR block prefix length must be between /%d and /%d", ipv4VPCMinPrefixLength, ipv4SubnetMaxPrefixLength)
+		}
+		if prefixLength > ipv4SubnetMaxPrefixLength {
+			return nil, fmt.Errorf("%s cannot be split into %d subnets no smaller than /%d", cidr, count, ipv4SubnetMaxPrefixLength)
+		}
+	} else {
+		if prefix.Bits() < ipv6VPCMinPrefixLength || prefix.Bits() > ipv6SubnetPrefixLength {
+			return nil, fmt.Errorf("IPv6 VPC CIDR block prefix length must be between /%d and /%d", ipv6VPCMinPrefixLength, ipv6SubnetPrefixLength)
+		}
+		if prefixLength > ipv6SubnetPrefixLength {
+			return nil, fmt.Errorf("%s cannot be split into %d /%d subnets", cidr, count, ipv6SubnetPrefixLength)
+		}
+		prefixLength = ipv6SubnetPrefixLength
+	}
+
+	addrBits := prefix.Addr().BitLen()
+	base := new(big.Int).SetBytes(prefix.Addr().AsSlice())
+	step := new(big.Int).Lsh(big.NewInt(1), uint(addrBits-prefixLength))
+
+	subnets := make([]string, 0, count)
+	for i := range count {
+		v := new(big.Int).Add(base, new(big.Int).Mul(step, big.NewInt(i)))
+		b := v.FillBytes(make([]byte, addrBits/8))
+		addr, _ := netip.AddrFromSlice(b)
+		subnets = append(subnets, netip.PrefixFrom(addr, prefixLength).String())
+	}
+
+	return subnets, nil
+}
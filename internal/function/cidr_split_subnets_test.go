@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSplitSubnetsFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSplitSubnetsFunctionConfig("10.0.0.0/16", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "10.0.0.0/18,10.0.64.0/18,10.0.128.0/18"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSplitSubnetsFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSplitSubnetsFunctionConfig("2600:1f14:abc:de00::/56", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2600:1f14:abc:de00::/64,2600:1f14:abc:de01::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSplitSubnetsFunction_tooManySubnets(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSplitSubnetsFunctionConfig("10.0.0.0/24", 17),
+				ExpectError: regexache.MustCompile(`cannot[\s\n]*be[\s\n]*split`),
+			},
+		},
+	})
+}
+
+func TestCIDRSplitSubnetsFunction_invalidVPC(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSplitSubnetsFunctionConfig("10.0.0.0/8", 2),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+			{
+				Config:      testCIDRSplitSubnetsFunctionConfig("10.0.1.0/16", 2),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*network[\s\n]*address`),
+			},
+		},
+	})
+}
+
+func testCIDRSplitSubnetsFunctionConfig(cidr string, count int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::cidr_split_subnets(%[1]q, %[2]d))
+}
+`, cidr, count)
+}
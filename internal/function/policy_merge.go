@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	policyVersion = "2012-10-17"
+)
+
+var _ function.Function = policyMergeFunction{}
+
+func NewPolicyMergeFunction() function.Function {
+	return &policyMergeFunction{}
+}
+
+type policyMergeFunction struct{}
+
+func (f policyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_merge"
+}
+
+func (f policyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_merge Function",
+		MarkdownDescription: "Merges the statements of IAM policy documents into a single policy document. " +
+			"A statement with the same `Sid` as a statement in an earlier document replaces it. " +
+			"Statements equivalent to an earlier statement are omitted.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IAM policy documents JSON",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f policyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := policyMerge(policies)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type policyDocument struct {
+	Version   string `json:",omitempty"`
+	Id        string `json:",omitempty"` //nolint:stylecheck // IAM policy element name
+	Statement []any
+}
+
+func policyMerge(policies []string) (string, error) {
+	merged := policyDocument{
+		Version: policyVersion,
+	}
+	sids := make(map[string]int)
+
+	for i, policy := range policies {
+		var doc struct {
+			Version   string
+			Id        string //nolint:stylecheck // IAM policy element name
+			Statement json.RawMessage
+		}
+
+		if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+			return "", fmt.Errorf("policy %d: %w", i, err)
+		}
+
+		if merged.Id == "" {
+			merged.Id = doc.Id
+		}
+
+		// Statement is either a single statement or a list of statements.
+		var statements []any
+		if len(doc.Statement) > 0 {
+			var v any
+			if err := json.Unmarshal(doc.Statement, &v); err != nil {
+				return "", fmt.Errorf("policy %d: %w", i, err)
+			}
+
+			switch v := v.(type) {
+			case []any:
+				statements = v
+			case map[string]any:
+				statements = []any{v}
+			default:
+				return "", fmt.Errorf("policy %d: Statement must be an object or a list of objects", i)
+			}
+		}
+
+	statements:
+		for _, statement := range statements {
+			m, ok := statement.(map[string]any)
+			if !ok {
+				return "", fmt.Errorf("policy %d: Statement must be an object or a list of objects", i)
+			}
+
+			if sid, ok := m["Sid"].(string); ok && sid != "" {
+				if j, ok := sids[sid]; ok {
+					merged.Statement[j] = m
+					continue
+				}
+				sids[sid] = len(merged.Statement)
+				merged.Statement = append(merged.Statement, m)
+				continue
+			}
+
+			for _, v := range merged.Statement {
+				if statementsEquivalent(v, m) {
+					continue statements
+				}
+			}
+			merged.Statement = append(merged.Statement, m)
+		}
+	}
+
+	if merged.Statement == nil {
+		merged.Statement = []any{}
+	}
+
+	output, err := json.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+// statementsEquivalent reports whether two policy statements are semantically equivalent.
+func statementsEquivalent(s1, s2 any) bool {
+	policy := func(statement any) string {
+		output, _ := json.Marshal(policyDocument{
+			Version:   policyVersion,
+			Statement: []any{statement},
+		})
+		return string(output)
+	}
+
+	return verify.PolicyStringsEquivalent(policy(s1), policy(s2))
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = userAgentFunction{}
+
+func NewUserAgentFunction() function.Function {
+	return &userAgentFunction{}
+}
+
+type userAgentFunction struct{}
+
+func (f userAgentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "user_agent"
+}
+
+func (f userAgentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "user_agent Function",
+		MarkdownDescription: "Formats a product name, version and comment as a User-Agent product token, " +
+			"such as can be appended to AWS API requests with the `TF_APPEND_USER_AGENT` environment variable.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Product name",
+			},
+			function.StringParameter{
+				Name:                "version",
+				MarkdownDescription: "Product version. May be empty",
+			},
+			function.StringParameter{
+				Name:                "comment",
+				MarkdownDescription: "Comment. May be empty",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f userAgentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, version, comment string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name, &version, &comment))
+	if resp.Error != nil {
+		return
+	}
+
+	if name == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "name must not be empty"))
+		return
+	}
+
+	result := awsbase.UserAgentProducts{
+		{Name: name, Version: version, Comment: comment},
+	}.BuildUserAgentString()
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -21,12 +21,12 @@ func ParseArgs(s string) Args {
 	var key string
 
 	for s != "" {
-		key, s, _ = strings.Cut(s, ",")
+		key, s, _ = cutUnquoted(s, ',')
 		key = strings.TrimSpace(key)
 		if key == "" {
 			continue
 		}
-		key, value, _ := strings.Cut(key, "=")
+		key, value, _ := cutUnquoted(key, '=')
 		// Unquote.
 		key = strings.Trim(key, `"`)
 		value = strings.Trim(value, `"`)
@@ -39,3 +39,21 @@ func ParseArgs(s string) Args {
 
 	return args
 }
+
+// cutUnquoted is like strings.Cut, but ignores any occurrence of sep between double quotes.
+func cutUnquoted(s string, sep byte) (before, after string, found bool) {
+	quoted := false
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			quoted = !quoted
+		case sep:
+			if !quoted {
+				return s[:i], s[i+1:], true
+			}
+		}
+	}
+
+	return s, "", false
+}
@@ -117,3 +117,26 @@ func TestArgsPostionalAndKeywords(t *testing.T) {
 		t.Errorf("Keyword[type] = %v, want %v", got, want)
 	}
 }
+
+func TestArgsQuotedSeparators(t *testing.T) {
+	t.Parallel()
+
+	input := `"bus/rule,target", id="a=b,c", vv=42`
+	args := ParseArgs(input)
+
+	if got, want := len(args.Positional), 1; got != want {
+		t.Errorf("length of Positional = %v, want %v", got, want)
+	}
+	if got, want := args.Positional[0], "bus/rule,target"; got != want {
+		t.Errorf("Positional[0] = %v, want %v", got, want)
+	}
+	if got, want := len(args.Keyword), 2; got != want {
+		t.Errorf("length of Keyword = %v, want %v", got, want)
+	}
+	if got, want := args.Keyword["id"], "a=b,c"; got != want {
+		t.Errorf("Keyword[id] = %v, want %v", got, want)
+	}
+	if got, want := args.Keyword["vv"], "42"; got != want {
+		t.Errorf("Keyword[vv] = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+const (
+	filename = `resource_ids_gen_test.go`
+)
+
+func main() {
+	g := common.NewGenerator()
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating resource ID tests for internal/service/%s", servicePackage)
+
+	v := &visitor{
+		g:         g,
+		functions: make(map[string]*ast.FuncDecl),
+	}
+
+	v.processDir(".")
+
+	if err := errors.Join(v.errs...); err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	resources, err := v.resolve()
+
+	if err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	if len(resources) == 0 {
+		g.Fatalf("no @IDHelpers annotations found in internal/service/%s", servicePackage)
+	}
+
+	slices.SortFunc(resources, func(a, b ResourceDatum) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	td := TemplateData{
+		PackageName: servicePackage,
+		Resources:   resources,
+	}
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("idtests", testGoTmpl, td); err != nil {
+		g.Fatalf("generating %q resource ID tests: %s", servicePackage, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+type ResourceDatum struct {
+	Name            string
+	ParseFunc       string
+	CreateFunc      string
+	CreateHasError  bool
+	ParseHasError   bool
+	PartCount       int
+	ValidExamples   []IDExample
+	InvalidExamples []IDExample
+
+	functionName string
+}
+
+// Parts returns the variable names of the parsed ID parts.
+// The parts are discarded if there is no create function.
+func (d ResourceDatum) Parts() []string {
+	parts := make([]string, d.PartCount)
+
+	for i := range parts {
+		if d.CreateFunc == "" {
+			parts[i] = "_"
+		} else {
+			parts[i] = fmt.Sprintf("part%d", i)
+		}
+	}
+
+	return parts
+}
+
+type IDExample struct {
+	ID string
+}
+
+// Lintignore returns the providerlint checks to ignore for the example, if any.
+// Examples containing hardcoded Regions (AWSAT003) or ARNs (AWSAT005) are realistic resource IDs, not test configuration.
+func (e IDExample) Lintignore() string {
+	var checks []string
+
+	if hardcodedRegion.MatchString(e.ID) {
+		checks = append(checks, "AWSAT003")
+	}
+	if hardcodedARN.MatchString(e.ID) {
+		checks = append(checks, "AWSAT005")
+	}
+
+	if len(checks) == 0 {
+		return ""
+	}
+
+	return "//lintignore:" + strings.Join(checks, ",")
+}
+
+type TemplateData struct {
+	PackageName string
+	Resources   []ResourceDatum
+}
+
+//go:embed test.go.gtpl
+var testGoTmpl string
+
+// Annotation processing.
+var (
+	annotation = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`)
+	// Characters that can't appear in a test function name, e.g. in "Add-On".
+	nonIdentifier = regexache.MustCompile(`[^0-9A-Za-z]`)
+	// Resource factory function names, e.g. "resourceRule", "ResourceQueue", "newAddonResource" or "newResourceMultiplexProgram".
+	factoryFunction = regexache.MustCompile(`^(?:[Rr]esource([0-9A-Z].*)|[Nn]ew([0-9A-Z].*)Resource|[Nn]ewResource([0-9A-Z].*))$`)
+)
+
+// Example ID contents that providerlint reports.
+var (
+	hardcodedARN    = regexache.MustCompile(`arn:aws[0-9a-z-]*:`)
+	hardcodedRegion = regexache.MustCompile(`(?:af|ap|ca|cn|eu|il|me|mx|sa|us)(?:-gov|-iso[a-z]?)?-(?:central|north|northeast|northwest|south|southeast|southwest|east|west)-[0-9]`)
+)
+
+type visitor struct {
+	errs []error
+	g    *common.Generator
+
+	fileName     string
+	functionName string
+	packageName  string
+
+	functions map[string]*ast.FuncDecl
+	resources []ResourceDatum
+}
+
+// processDir scans a single service package directory and processes contained Go sources files.
+func (v *visitor) processDir(path string) {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
+
+		return
+	}
+
+	for name, pkg := range packageMap {
+		v.packageName = name
+
+		for name, file := range pkg.Files {
+			v.fileName = name
+
+			v.processFile(file)
+
+			v.fileName = ""
+		}
+	}
+}
+
+// processFile processes a single Go source file.
+func (v *visitor) processFile(file *ast.File) {
+	ast.Walk(v, file)
+}
+
+// processFuncDecl processes a single Go function.
+// The function's comments are scanned for resource ID annotations.
+func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
+	v.functionName = funcDecl.Name.Name
+
+	d := ResourceDatum{
+		functionName: v.functionName,
+	}
+	annotated := false
+
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 {
+			args := common.ParseArgs(m[3])
+
+			switch annotationName := m[1]; annotationName {
+			case "FrameworkResource", "SDKResource":
+				if attr, ok := args.Keyword["name"]; ok {
+					d.Name = nonIdentifier.ReplaceAllString(attr, "")
+				}
+
+			case "IDHelpers":
+				if annotated {
+					v.errs = append(v.errs, fmt.Errorf("multiple IDHelpers annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				annotated = true
+
+				if attr, ok := args.Keyword["parse"]; ok {
+					d.ParseFunc = attr
+				} else {
+					v.errs = append(v.errs, fmt.Errorf("no IDHelpers parse function: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				}
+
+				if attr, ok := args.Keyword["create"]; ok {
+					d.CreateFunc = attr
+				}
+
+			case "IDExample":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no IDExample value: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				valid := true
+
+				if attr, ok := args.Keyword["valid"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid valid value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else {
+						valid = b
+					}
+				}
+
+				if example := (IDExample{ID: args.Positional[0]}); valid {
+					d.ValidExamples = append(d.ValidExamples, example)
+				} else {
+					d.InvalidExamples = append(d.InvalidExamples, example)
+				}
+			}
+		}
+	}
+
+	if annotated {
+		// Resources registered without a name are named from their factory function.
+		if d.Name == "" {
+			if m := factoryFunction.FindStringSubmatch(v.functionName); len(m) > 0 {
+				d.Name = m[1] + m[2] + m[3]
+			} else {
+				d.Name = strings.ToUpper(v.functionName[:1]) + v.functionName[1:]
+			}
+		}
+
+		if len(d.ValidExamples) == 0 {
+			v.errs = append(v.errs, fmt.Errorf("no valid IDExample annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+		}
+
+		v.resources = append(v.resources, d)
+	} else if len(d.ValidExamples) > 0 || len(d.InvalidExamples) > 0 {
+		v.errs = append(v.errs, fmt.Errorf("IDExample annotation without IDHelpers: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+	}
+
+	v.functionName = ""
+}
+
+// Visit is called for each node visited by ast.Walk.
+func (v *visitor) Visit(node ast.Node) ast.Visitor {
+	// Look at functions (not methods).
+	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil {
+		v.functions[funcDecl.Name.Name] = funcDecl
+
+		if funcDecl.Doc != nil {
+			v.processFuncDecl(funcDecl)
+		}
+	}
+
+	return v
+}
+
+// resolve checks the signatures of each annotated resource's ID helper functions.
+// The parse function must return one or more ID parts, optionally followed by an error.
+// Invalid examples can only be checked if the parse function returns an error.
+// The create function, if any, must accept the same ID parts and return a string and optionally an error.
+func (v *visitor) resolve() ([]ResourceDatum, error) {
+	var errs []error
+
+	for i, d := range v.resources {
+		source := fmt.Sprintf("%s.%s", v.packageName, d.functionName)
+
+		parse, ok := v.functions[d.ParseFunc]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: parse function not found: %s", source, d.ParseFunc))
+			continue
+		}
+
+		parts := fieldTypes(parse.Type.Results)
+		if n := len(parts); n > 0 && parts[n-1] == "error" {
+			d.ParseHasError = true
+			parts = parts[:n-1]
+		}
+		if len(parts) == 0 {
+			errs = append(errs, fmt.Errorf("%s: parse function %s must return ID parts", source, d.ParseFunc))
+			continue
+		}
+		if !d.ParseHasError && len(d.InvalidExamples) > 0 {
+			errs = append(errs, fmt.Errorf("%s: parse function %s does not return an error, invalid IDExample annotations not allowed", source, d.ParseFunc))
+			continue
+		}
+		d.PartCount = len(parts)
+
+		if d.CreateFunc != "" {
+			create, ok := v.functions[d.CreateFunc]
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: create function not found: %s", source, d.CreateFunc))
+				continue
+			}
+
+			if params := fieldTypes(create.Type.Params); !slices.Equal(params, parts) {
+				errs = append(errs, fmt.Errorf("%s: create function %s parameters (%s) do not match parse function %s results (%s)", source, d.CreateFunc, strings.Join(params, ", "), d.ParseFunc, strings.Join(parts, ", ")))
+				continue
+			}
+
+			switch results := fieldTypes(create.Type.Results); {
+			case slices.Equal(results, []string{"string"}):
+			case slices.Equal(results, []string{"string", "error"}):
+				d.CreateHasError = true
+			default:
+				errs = append(errs, fmt.Errorf("%s: create function %s must return a string and optionally an error", source, d.CreateFunc))
+				continue
+			}
+		}
+
+		v.resources[i] = d
+	}
+
+	return v.resources, errors.Join(errs...)
+}
+
+// fieldTypes returns the type of each field in the specified list, expanding grouped names such as `a, b string`.
+func fieldTypes(fields *ast.FieldList) []string {
+	var typs []string
+
+	if fields == nil {
+		return typs
+	}
+
+	for _, field := range fields.List {
+		typ := types.ExprString(field.Type)
+		n := max(len(field.Names), 1)
+
+		for range n {
+			typs = append(typs, typ)
+		}
+	}
+
+	return typs
+}
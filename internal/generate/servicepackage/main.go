@@ -16,10 +16,13 @@ import (
 	"os"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	tfidentity "github.com/hashicorp/terraform-provider-aws/internal/identity"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 )
@@ -53,6 +56,7 @@ func main() {
 		v := &visitor{
 			g: g,
 
+			ephemeralResources:   make([]ResourceDatum, 0),
 			frameworkDataSources: make([]ResourceDatum, 0),
 			frameworkResources:   make([]ResourceDatum, 0),
 			sdkDataSources:       make(map[string]ResourceDatum),
@@ -71,6 +75,7 @@ func main() {
 			GoV2Package:          l.GoV2Package(),
 			ProviderPackage:      p,
 			ProviderNameUpper:    l.ProviderNameUpper(),
+			EphemeralResources:   v.ephemeralResources,
 			FrameworkDataSources: v.frameworkDataSources,
 			FrameworkResources:   v.frameworkResources,
 			SDKDataSources:       v.sdkDataSources,
@@ -82,6 +87,9 @@ func main() {
 			s.GoV1ClientTypeName = l.GoV1ClientTypeName()
 		}
 
+		sort.SliceStable(s.EphemeralResources, func(i, j int) bool {
+			return s.EphemeralResources[i].FactoryName < s.EphemeralResources[j].FactoryName
+		})
 		sort.SliceStable(s.FrameworkDataSources, func(i, j int) bool {
 			return s.FrameworkDataSources[i].FactoryName < s.FrameworkDataSources[j].FactoryName
 		})
@@ -89,6 +97,20 @@ func main() {
 			return s.FrameworkResources[i].FactoryName < s.FrameworkResources[j].FactoryName
 		})
 
+		for _, d := range s.FrameworkResources {
+			if d.IdentityAnnotated {
+				s.Identities = append(s.Identities, d)
+			}
+		}
+		for _, d := range s.SDKResources {
+			if d.IdentityAnnotated {
+				s.Identities = append(s.Identities, d)
+			}
+		}
+		sort.SliceStable(s.Identities, func(i, j int) bool {
+			return s.Identities[i].FactoryName < s.Identities[j].FactoryName
+		})
+
 		d := g.NewGoFileDestination(filename)
 
 		if err := d.WriteTemplate("servicepackagedata", tmpl, s); err != nil {
@@ -109,6 +131,18 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	RegionAnnotated         bool
+	RegionIsGlobal          bool
+	RegionOverrideEnabled   bool
+	ImportAnnotated         bool
+	ImportARNService        string
+	ImportARNResource       string
+	ImportID                string
+	IdentityAnnotated       bool
+	IdentityAttributes      []string
+	IdentitySeparator       string
+	IdentityARNFormat       string
+	Stateful                bool
 }
 
 type ServiceDatum struct {
@@ -119,10 +153,12 @@ type ServiceDatum struct {
 	GoV2Package          string // AWS SDK for Go v2 package name
 	ProviderPackage      string
 	ProviderNameUpper    string
+	EphemeralResources   []ResourceDatum
 	FrameworkDataSources []ResourceDatum
 	FrameworkResources   []ResourceDatum
 	SDKDataSources       map[string]ResourceDatum
 	SDKResources         map[string]ResourceDatum
+	Identities           []ResourceDatum
 }
 
 //go:embed file.tmpl
@@ -141,6 +177,7 @@ type visitor struct {
 	functionName string
 	packageName  string
 
+	ephemeralResources   []ResourceDatum
 	frameworkDataSources []ResourceDatum
 	frameworkResources   []ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
@@ -186,12 +223,116 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging, Region and import annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Region" {
+			args := common.ParseArgs(m[3])
+
+			if d.RegionAnnotated {
+				v.errs = append(v.errs, fmt.Errorf("multiple Region annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.RegionAnnotated = true
+
+			if attr, ok := args.Keyword["global"]; ok {
+				if global, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region global value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.RegionIsGlobal = global
+				}
+			}
+
+			// Global resources never support Region override.
+			d.RegionOverrideEnabled = !d.RegionIsGlobal
+
+			if attr, ok := args.Keyword["overrideEnabled"]; ok {
+				if enabled, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid Region overrideEnabled value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else if enabled && d.RegionIsGlobal {
+					v.errs = append(v.errs, fmt.Errorf("global resource cannot enable Region override: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					d.RegionOverrideEnabled = enabled
+				}
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "ImportFromARN" {
+			args := common.ParseArgs(m[3])
+
+			if d.ImportAnnotated {
+				v.errs = append(v.errs, fmt.Errorf("multiple ImportFromARN annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.ImportAnnotated = true
+
+			if attr, ok := args.Keyword["service"]; ok {
+				d.ImportARNService = attr
+			} else {
+				v.errs = append(v.errs, fmt.Errorf("no ImportFromARN service: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			if attr, ok := args.Keyword["resource"]; ok {
+				d.ImportARNResource = attr
+			} else {
+				v.errs = append(v.errs, fmt.Errorf("no ImportFromARN resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			if attr, ok := args.Keyword["id"]; ok {
+				d.ImportID = attr
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Identity" {
+			args := common.ParseArgs(m[3])
+
+			if d.IdentityAnnotated {
+				v.errs = append(v.errs, fmt.Errorf("multiple Identity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.IdentityAnnotated = true
+
+			if attr, ok := args.Keyword["attributes"]; ok {
+				for _, attr := range strings.Split(attr, ";") {
+					d.IdentityAttributes = append(d.IdentityAttributes, strings.TrimSpace(attr))
+				}
+			} else {
+				v.errs = append(v.errs, fmt.Errorf("no Identity attributes: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			if attr, ok := args.Keyword["separator"]; ok {
+				d.IdentitySeparator = attr
+			} else if len(d.IdentityAttributes) > 1 {
+				// Multi-part IDs are comma-separated by default, as are those created by flex.FlattenResourceId.
+				d.IdentitySeparator = ","
+			}
+
+			if attr, ok := args.Keyword["arn"]; ok {
+				d.IdentityARNFormat = attr
+			}
+
+			identity := &types.ServicePackageResourceIdentity{
+				IDAttributes: d.IdentityAttributes,
+				IDSeparator:  d.IdentitySeparator,
+				ARNFormat:    d.IdentityARNFormat,
+			}
+
+			if err := tfidentity.Validate(identity); err != nil {
+				v.errs = append(v.errs, fmt.Errorf("invalid Identity annotation: %s: %w", fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+			}
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Stateful" {
+			if d.Stateful {
+				v.errs = append(v.errs, fmt.Errorf("multiple Stateful annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.Stateful = true
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -224,6 +365,12 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			}
 
 			switch annotationName := m[1]; annotationName {
+			case "EphemeralResource":
+				if slices.ContainsFunc(v.ephemeralResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
+					v.errs = append(v.errs, fmt.Errorf("duplicate Ephemeral Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.ephemeralResources = append(v.ephemeralResources, d)
+				}
 			case "FrameworkDataSource":
 				if slices.ContainsFunc(v.frameworkDataSources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -262,9 +409,9 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "Identity", "ImportFromARN", "Region", "Stateful", "Tags":
 				// Handled above.
-			case "Testing":
+			case "IDExample", "IDHelpers", "Testing":
 				// Ignored.
 			default:
 				v.g.Warnf("unknown annotation: %s", annotationName)
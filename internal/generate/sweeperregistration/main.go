@@ -9,6 +9,7 @@ package main
 import (
 	_ "embed"
 	"errors"
+	"flag"
 	"fmt"
 	"io/fs"
 	"os"
@@ -28,9 +29,14 @@ type TemplateData struct {
 }
 
 func main() {
-	const (
-		filename = `register_gen_test.go`
-	)
+	filename := `register_gen_test.go`
+
+	flag.Parse()
+	args := flag.Args()
+	if len(args) > 0 {
+		filename = args[0]
+	}
+
 	g := common.NewGenerator()
 
 	packageName := os.Getenv("GOPACKAGE")
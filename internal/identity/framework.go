@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ImportState sets a Plugin Framework resource's ID attributes, and its `id` attribute if it has one, from the import ID.
+func ImportState(ctx context.Context, v *types.ServicePackageResourceIdentity, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := ParseID(v, request.ID)
+
+	if err != nil {
+		response.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	for i, attr := range v.IDAttributes {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(attr), parts[i])...)
+	}
+
+	if !slices.Contains(v.IDAttributes, names.AttrID) {
+		if _, diags := response.State.Schema.AttributeAtPath(ctx, path.Root(names.AttrID)); !diags.HasError() {
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+		}
+	}
+}
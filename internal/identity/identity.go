@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package identity implements the resource IDs and ARNs declared via the `@Identity` annotation.
+package identity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	PartPartition = "partition"
+	PartRegion    = "region"
+	PartAccount   = "account"
+)
+
+var placeholder = regexache.MustCompile(`\{([0-9A-Za-z_]+)\}`)
+
+// Validate returns an error if the specified identity is not well formed.
+func Validate(v *types.ServicePackageResourceIdentity) error {
+	var errs []error
+
+	if len(v.IDAttributes) == 0 {
+		errs = append(errs, errors.New("no ID attributes"))
+	}
+
+	if len(v.IDAttributes) > 1 && v.IDSeparator == "" {
+		errs = append(errs, errors.New("no ID separator"))
+	}
+
+	for i, attr := range v.IDAttributes {
+		if slices.Contains(v.IDAttributes[:i], attr) {
+			errs = append(errs, fmt.Errorf("duplicate ID attribute (%s)", attr))
+		}
+	}
+
+	if v.ARNFormat != "" {
+		if parts := strings.SplitN(v.ARNFormat, ":", 6); len(parts) != 6 || parts[0] != "arn" || parts[1] != "{"+PartPartition+"}" || parts[2] == "" {
+			errs = append(errs, fmt.Errorf("invalid ARN format (%s)", v.ARNFormat))
+		}
+
+		for _, m := range placeholder.FindAllStringSubmatch(v.ARNFormat, -1) {
+			if name := m[1]; !isARNPart(name) && !slices.Contains(v.IDAttributes, name) {
+				errs = append(errs, fmt.Errorf("unknown ARN format part (%s)", name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Format returns a human-readable description of the ID format, e.g. "FUNCTION_NAME,QUALIFIER".
+func Format(v *types.ServicePackageResourceIdentity) string {
+	parts := make([]string, len(v.IDAttributes))
+	for i, attr := range v.IDAttributes {
+		parts[i] = strings.ToUpper(attr)
+	}
+
+	return strings.Join(parts, v.IDSeparator)
+}
+
+// ParseID splits the specified resource ID into its parts, one per ID attribute.
+func ParseID(v *types.ServicePackageResourceIdentity, id string) ([]string, error) {
+	var parts []string
+
+	if n := len(v.IDAttributes); n == 1 {
+		parts = []string{id}
+	} else {
+		parts = strings.Split(id, v.IDSeparator)
+		if len(parts) != n {
+			return nil, fmt.Errorf("unexpected format for ID (%s), expected %s", id, Format(v))
+		}
+	}
+
+	if slices.Contains(parts, "") {
+		return nil, fmt.Errorf("unexpected format for ID (%s), expected %s", id, Format(v))
+	}
+
+	return parts, nil
+}
+
+// FormatID returns the resource ID made up of the specified parts, one per ID attribute.
+func FormatID(v *types.ServicePackageResourceIdentity, parts ...string) (string, error) {
+	if len(parts) != len(v.IDAttributes) {
+		return "", fmt.Errorf("unexpected number of ID parts (%d), expected %s", len(parts), Format(v))
+	}
+
+	for i, part := range parts {
+		if part == "" {
+			return "", fmt.Errorf("empty ID part (%s)", v.IDAttributes[i])
+		}
+
+		if len(parts) > 1 && strings.Contains(part, v.IDSeparator) {
+			return "", fmt.Errorf("ID part (%s) contains separator (%s): %s", v.IDAttributes[i], v.IDSeparator, part)
+		}
+	}
+
+	return strings.Join(parts, v.IDSeparator), nil
+}
+
+// FormatARN returns the resource's ARN from the ARN format.
+// values holds the values of the ID attributes that are referenced in the ARN format.
+func FormatARN(v *types.ServicePackageResourceIdentity, partition, region, accountID string, values map[string]string) (string, error) {
+	if v.ARNFormat == "" {
+		return "", errors.New("no ARN format")
+	}
+
+	var errs []error
+
+	arn := placeholder.ReplaceAllStringFunc(v.ARNFormat, func(s string) string {
+		switch name := s[1 : len(s)-1]; name {
+		case PartPartition:
+			return partition
+		case PartRegion:
+			return region
+		case PartAccount:
+			return accountID
+		default:
+			value, ok := values[name]
+			if !ok || value == "" {
+				errs = append(errs, fmt.Errorf("no value for ARN format part (%s)", name))
+			}
+			return value
+		}
+	})
+
+	if err := errors.Join(errs...); err != nil {
+		return "", err
+	}
+
+	return arn, nil
+}
+
+func isARNPart(name string) bool {
+	return name == PartPartition || name == PartRegion || name == PartAccount
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identity    *types.ServicePackageResourceIdentity
+		expectError bool
+	}{
+		"single part": {
+			identity: &types.ServicePackageResourceIdentity{
+				IDAttributes: []string{"name"},
+				ARNFormat:    "arn:{partition}:dynamodb:{region}:{account}:table/{name}",
+			},
+		},
+		"multiple parts": {
+			identity: &types.ServicePackageResourceIdentity{
+				IDAttributes: []string{"function_name", "qualifier"},
+				IDSeparator:  ",",
+			},
+		},
+		"no attributes": {
+			identity:    &types.ServicePackageResourceIdentity{},
+			expectError: true,
+		},
+		"no separator": {
+			identity: &types.ServicePackageResourceIdentity{
+				IDAttributes: []string{"function_name", "qualifier"},
+			},
+			expectError: true,
+		},
+		"duplicate attribute": {
+			identity: &types.ServicePackageResourceIdentity{
+				IDAttributes: []string{"name", "name"},
+				IDSeparator:  ",",
+			},
+			expectError: true,
+		},
+		"invalid ARN format": {
+			identity: &types.ServicePackageResourceIdentity{
+				IDAttributes: []string{"name"},
+				ARNFormat:    "arn:aws:dynamodb:{region}:{account}:table/{name}",
+			},
+			expectError: true,
+		},
+		"unknown ARN format part": {
+			identity: &types.ServicePackageResourceIdentity{
+				IDAttributes: []string{"name"},
+				ARNFormat:    "arn:{partition}:dynamodb:{region}:{account}:table/{table_name}",
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Validate(testCase.identity)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("err = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
+
+func TestParseAndFormatID(t *testing.T) {
+	t.Parallel()
+
+	identity := &types.ServicePackageResourceIdentity{
+		IDAttributes: []string{"table_name", "stream_arn"},
+		IDSeparator:  ",",
+	}
+
+	testCases := map[string]struct {
+		id            string
+		expectedParts []string
+		expectError   bool
+	}{
+		"valid": {
+			id:            "my-table,arn:aws:kinesis:us-west-2:123456789012:stream/my-stream",
+			expectedParts: []string{"my-table", "arn:aws:kinesis:us-west-2:123456789012:stream/my-stream"},
+		},
+		"too few parts": {
+			id:          "my-table",
+			expectError: true,
+		},
+		"too many parts": {
+			id:          "my-table,my-stream,extra",
+			expectError: true,
+		},
+		"empty part": {
+			id:          "my-table,",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			parts, err := ParseID(identity, testCase.id)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("err = %v, expectError = %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(parts, testCase.expectedParts); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			id, err := FormatID(identity, parts...)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := id, testCase.id; got != want {
+				t.Errorf("FormatID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestParseIDSinglePart(t *testing.T) {
+	t.Parallel()
+
+	identity := &types.ServicePackageResourceIdentity{
+		IDAttributes: []string{"name"},
+	}
+
+	parts, err := ParseID(identity, "a,b")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(parts, []string{"a,b"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if _, err := ParseID(identity, ""); err == nil {
+		t.Error("expected error for empty ID")
+	}
+}
+
+func TestFormatIDInvalid(t *testing.T) {
+	t.Parallel()
+
+	identity := &types.ServicePackageResourceIdentity{
+		IDAttributes: []string{"user_group_id", "user_id"},
+		IDSeparator:  ",",
+	}
+
+	for _, parts := range [][]string{
+		{"group"},
+		{"group", ""},
+		{"group", "user,other"},
+	} {
+		if _, err := FormatID(identity, parts...); err == nil {
+			t.Errorf("FormatID(%v): expected error", parts)
+		}
+	}
+}
+
+func TestFormatARN(t *testing.T) {
+	t.Parallel()
+
+	identity := &types.ServicePackageResourceIdentity{
+		IDAttributes: []string{"name"},
+		ARNFormat:    "arn:{partition}:dynamodb:{region}:{account}:table/{name}",
+	}
+
+	arn, err := FormatARN(identity, "aws-us-gov", "us-gov-west-1", "123456789012", map[string]string{"name": "my-table"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := arn, "arn:aws-us-gov:dynamodb:us-gov-west-1:123456789012:table/my-table"; got != want {
+		t.Errorf("FormatARN = %q, want %q", got, want)
+	}
+
+	if _, err := FormatARN(identity, "aws", "us-west-2", "123456789012", nil); err == nil {
+		t.Error("expected error for missing value")
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ImportStateContext returns a Plugin SDK v2 importer that sets a resource's ID attributes from the import ID.
+func ImportStateContext(v *types.ServicePackageResourceIdentity) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		parts, err := ParseID(v, d.Id())
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i, attr := range v.IDAttributes {
+			if err := d.Set(attr, parts[i]); err != nil {
+				return nil, err
+			}
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 // ProtoV5ProviderServerFactory returns a muxed terraform-plugin-go protocol v5 provider factory function.
@@ -34,5 +36,30 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	meta := primary.Meta().(*conns.AWSClient)
+	typeNames, err := statefulResourceTypeNames(ctx, meta.ServicePackages)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Plugin SDK resource tags are checked against the configured provider's tag policy.
+	tagPolicyServer := newTagPolicyResourceServer(muxServer.ProviderServer(), tagPolicyResourceTypeNames(ctx, meta.ServicePackages), func(context.Context) *tftags.PolicyConfig {
+		if v, ok := primary.Meta().(*conns.AWSClient); ok {
+			return v.TagPolicyConfig
+		}
+
+		return nil
+	})
+
+	// Replacement or deletion of stateful resources is checked against the configured provider's Meta.
+	server := newStatefulResourceServer(tagPolicyServer, typeNames, func(ctx context.Context, typeName string) bool {
+		if v, ok := primary.Meta().(*conns.AWSClient); ok {
+			return v.StatefulResourceProtected(ctx, typeName)
+		}
+
+		return false
+	})
+
+	return func() tfprotov5.ProviderServer { return server }, primary, nil
 }
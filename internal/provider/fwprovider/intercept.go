@@ -6,16 +6,23 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -67,6 +74,12 @@ type resourceInterceptor interface {
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
+// A resourceModifyPlanInterceptor is functionality invoked during the resource's ModifyPlan request,
+// after any plan modification by the resource itself.
+type resourceModifyPlanInterceptor interface {
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, diag.Diagnostics) (context.Context, diag.Diagnostics)
+}
+
 type resourceInterceptors []resourceInterceptor
 
 type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] interceptorFunc[Request, Response]
@@ -193,6 +206,155 @@ func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCR
 // contextFunc augments Context.
 type contextFunc func(context.Context, *conns.AWSClient) context.Context
 
+// regionAttributeDescription is the description of the `region` attribute added to resources and data sources that support per-resource Region override.
+const regionAttributeDescription = "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration."
+
+// getRegion returns the value of the `region` attribute from the specified Terraform configuration, plan or state.
+func getRegion(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics) (string, diag.Diagnostics) {
+	var region fwtypes.String
+	diags := getAttribute(ctx, path.Root(names.AttrRegion), &region)
+
+	return region.ValueString(), diags
+}
+
+// The `region` attribute is added to resource and data source schemas by the wrappers below.
+// Inner resources and data sources don't declare it, so it's removed from any configuration, plan or state
+// passed to them and restored in any plan or state they return.
+
+// schemaWithoutRegion returns a copy of the specified resource or data source schema without the `region` attribute.
+func schemaWithoutRegion[T any](schema T) T {
+	switch v := any(schema).(type) {
+	case dsschema.Schema:
+		v.Attributes = maps.Clone(v.Attributes)
+		delete(v.Attributes, names.AttrRegion)
+		return any(v).(T)
+	case rsschema.Schema:
+		v.Attributes = maps.Clone(v.Attributes)
+		delete(v.Attributes, names.AttrRegion)
+		return any(v).(T)
+	}
+
+	return schema
+}
+
+// objectWithoutRegion returns a copy of the specified object value without the `region` attribute,
+// together with the removed attribute's value.
+func objectWithoutRegion(v tftypes.Value) (tftypes.Value, tftypes.Value, error) {
+	region := tftypes.NewValue(tftypes.String, nil)
+
+	typ, ok := v.Type().(tftypes.Object)
+	if !ok {
+		return v, region, nil
+	}
+
+	typ = tftypes.Object{
+		AttributeTypes:     maps.Clone(typ.AttributeTypes),
+		OptionalAttributes: maps.Clone(typ.OptionalAttributes),
+	}
+	delete(typ.AttributeTypes, names.AttrRegion)
+	delete(typ.OptionalAttributes, names.AttrRegion)
+
+	switch {
+	case v.IsNull():
+		return tftypes.NewValue(typ, nil), region, nil
+	case !v.IsKnown():
+		return tftypes.NewValue(typ, tftypes.UnknownValue), region, nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return v, region, err
+	}
+
+	if v, ok := attributes[names.AttrRegion]; ok {
+		region = v
+		delete(attributes, names.AttrRegion)
+	}
+
+	return tftypes.NewValue(typ, attributes), region, nil
+}
+
+// objectWithRegion returns a copy of the specified object value, of the specified type, with the `region` attribute set.
+func objectWithRegion(v tftypes.Value, typ tftypes.Type, region tftypes.Value) (tftypes.Value, error) {
+	switch {
+	case v.IsNull():
+		return tftypes.NewValue(typ, nil), nil
+	case !v.IsKnown():
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return v, err
+	}
+
+	attributes[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, attributes), nil
+}
+
+func configWithoutRegion(config tfsdk.Config) (tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, _, err := objectWithoutRegion(config.Raw)
+	if err != nil {
+		diags.AddError("removing Region from configuration", err.Error())
+		return config, diags
+	}
+
+	return tfsdk.Config{Raw: raw, Schema: schemaWithoutRegion(config.Schema)}, diags
+}
+
+func planWithoutRegion(plan tfsdk.Plan) (tfsdk.Plan, tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, region, err := objectWithoutRegion(plan.Raw)
+	if err != nil {
+		diags.AddError("removing Region from plan", err.Error())
+		return plan, region, diags
+	}
+
+	return tfsdk.Plan{Raw: raw, Schema: schemaWithoutRegion(plan.Schema)}, region, diags
+}
+
+func stateWithoutRegion(state tfsdk.State) (tfsdk.State, tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, region, err := objectWithoutRegion(state.Raw)
+	if err != nil {
+		diags.AddError("removing Region from state", err.Error())
+		return state, region, diags
+	}
+
+	return tfsdk.State{Raw: raw, Schema: schemaWithoutRegion(state.Schema)}, region, diags
+}
+
+// planWithRegion returns the specified inner plan converted to the schema of the specified outer plan, with the `region` attribute set.
+func planWithRegion(ctx context.Context, inner, outer tfsdk.Plan, region tftypes.Value) (tfsdk.Plan, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := objectWithRegion(inner.Raw, outer.Schema.Type().TerraformType(ctx), region)
+	if err != nil {
+		diags.AddError("restoring Region in plan", err.Error())
+		return outer, diags
+	}
+
+	return tfsdk.Plan{Raw: raw, Schema: outer.Schema}, diags
+}
+
+// stateWithRegion returns the specified inner state converted to the schema of the specified outer state, with the `region` attribute set.
+func stateWithRegion(ctx context.Context, inner, outer tfsdk.State, region tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := objectWithRegion(inner.Raw, outer.Schema.Type().TerraformType(ctx), region)
+	if err != nil {
+		diags.AddError("restoring Region in state", err.Error())
+		return outer, diags
+	}
+
+	return tfsdk.State{Raw: raw, Schema: outer.Schema}, diags
+}
+
 // wrappedDataSource represents an interceptor dispatcher for a Plugin Framework data source.
 type wrappedDataSource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
@@ -200,14 +362,50 @@ type wrappedDataSource struct {
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// newInner is used to create inner data source instances for per-resource Region override.
+	newInner              func(context.Context) (datasource.DataSourceWithConfigure, error)
+	regionOverrideEnabled bool
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, regionOverrideEnabled bool, newInner func(context.Context) (datasource.DataSourceWithConfigure, error)) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
-		interceptors:     interceptors,
+		bootstrapContext:      bootstrapContext,
+		inner:                 inner,
+		interceptors:          interceptors,
+		newInner:              newInner,
+		regionOverrideEnabled: regionOverrideEnabled,
+	}
+}
+
+// forRegion returns the inner data source and provider Meta to use for the specified AWS Region.
+func (w *wrappedDataSource) forRegion(ctx context.Context, region string) (datasource.DataSourceWithConfigure, *conns.AWSClient, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !w.regionOverrideEnabled || w.meta == nil {
+		return w.inner, w.meta, diags
+	}
+
+	meta, err := w.meta.ForRegion(ctx, region)
+	if err != nil {
+		diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region Value", err.Error())
+		return nil, nil, diags
+	}
+
+	if meta == w.meta {
+		return w.inner, w.meta, diags
 	}
+
+	inner, err := w.newInner(ctx)
+	if err != nil {
+		diags.AddError("creating data source", err.Error())
+		return nil, nil, diags
+	}
+
+	response := datasource.ConfigureResponse{}
+	inner.Configure(ctx, datasource.ConfigureRequest{ProviderData: meta}, &response)
+	diags.Append(response.Diagnostics...)
+
+	return inner, meta, diags
 }
 
 func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
@@ -218,18 +416,71 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionOverrideEnabled {
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]dsschema.Attribute)
+		}
+		response.Schema.Attributes[names.AttrRegion] = dsschema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: regionAttributeDescription,
+		}
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	inner, meta, diags := w.innerFromConfig(ctx, request.Config)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if !w.regionOverrideEnabled {
+			inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		innerRequest, innerResponse := request, *response
+		innerRequest.Config, diags = configWithoutRegion(request.Config)
+		if diags.HasError() {
+			return diags
+		}
+		innerResponse.State, _, diags = stateWithoutRegion(response.State)
+		if diags.HasError() {
+			return diags
+		}
+
+		inner.Read(ctx, innerRequest, &innerResponse)
+
+		outer := response.State
+		*response = innerResponse
+		response.State, diags = stateWithRegion(ctx, innerResponse.State, outer, tftypes.NewValue(tftypes.String, nil))
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedDataSourceReadHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+	diags = interceptedDataSourceReadHandler(w.interceptors.read(), f, meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
+// innerFromConfig returns the inner data source and provider Meta to use for the AWS Region in the specified configuration.
+func (w *wrappedDataSource) innerFromConfig(ctx context.Context, config tfsdk.Config) (datasource.DataSourceWithConfigure, *conns.AWSClient, diag.Diagnostics) {
+	if !w.regionOverrideEnabled {
+		return w.inner, w.meta, nil
+	}
+
+	region, diags := getRegion(ctx, config.GetAttribute)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	return w.forRegion(ctx, region)
+}
+
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
 	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
 		w.meta = v
@@ -248,23 +499,156 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 	return ctx, diags
 }
 
+// regionDataSourceInterceptor sets the `region` attribute in state to the AWS Region used by the data source.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region)...)
+	}
+
+	return ctx, diags
+}
+
+// wrappedEphemeralResource represents a dispatcher for a Plugin Framework ephemeral resource.
+type wrappedEphemeralResource struct {
+	// bootstrapContext is run on all wrapped methods.
+	bootstrapContext contextFunc
+	inner            ephemeral.EphemeralResourceWithConfigure
+	meta             *conns.AWSClient
+}
+
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure) ephemeral.EphemeralResourceWithConfigure {
+	return &wrappedEphemeralResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+	}
+}
+
+func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Open(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Renew(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Close(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	inner            resource.ResourceWithConfigure
-	interceptors     resourceInterceptors
-	meta             *conns.AWSClient
+	// identity is used to import resources that don't implement their own import.
+	identity     *types.ServicePackageResourceIdentity
+	inner        resource.ResourceWithConfigure
+	interceptors resourceInterceptors
+	meta         *conns.AWSClient
+	// newInner is used to create inner resource instances for per-resource Region override.
+	newInner              func(context.Context) (resource.ResourceWithConfigure, error)
+	regionOverrideEnabled bool
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, regionOverrideEnabled bool, newInner func(context.Context) (resource.ResourceWithConfigure, error), identity *types.ServicePackageResourceIdentity) resource.ResourceWithConfigure {
 	return &wrappedResource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
-		interceptors:     interceptors,
+		bootstrapContext:      bootstrapContext,
+		identity:              identity,
+		inner:                 inner,
+		interceptors:          interceptors,
+		newInner:              newInner,
+		regionOverrideEnabled: regionOverrideEnabled,
 	}
 }
 
+// forRegion returns the inner resource and provider Meta to use for the specified AWS Region.
+func (w *wrappedResource) forRegion(ctx context.Context, region string) (resource.ResourceWithConfigure, *conns.AWSClient, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !w.regionOverrideEnabled || w.meta == nil {
+		return w.inner, w.meta, diags
+	}
+
+	meta, err := w.meta.ForRegion(ctx, region)
+	if err != nil {
+		diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region Value", err.Error())
+		return nil, nil, diags
+	}
+
+	if meta == w.meta {
+		return w.inner, w.meta, diags
+	}
+
+	inner, err := w.newInner(ctx)
+	if err != nil {
+		diags.AddError("creating resource", err.Error())
+		return nil, nil, diags
+	}
+
+	response := resource.ConfigureResponse{}
+	inner.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &response)
+	diags.Append(response.Diagnostics...)
+
+	return inner, meta, diags
+}
+
+// innerFromValue returns the inner resource and provider Meta to use for the AWS Region in the specified plan or state.
+func (w *wrappedResource) innerFromValue(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics) (resource.ResourceWithConfigure, *conns.AWSClient, diag.Diagnostics) {
+	if !w.regionOverrideEnabled {
+		return w.inner, w.meta, nil
+	}
+
+	region, diags := getRegion(ctx, getAttribute)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	return w.forRegion(ctx, region)
+}
+
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Metadata(ctx, request, response)
@@ -273,45 +657,184 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionOverrideEnabled {
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]rsschema.Attribute)
+		}
+		response.Schema.Attributes[names.AttrRegion] = rsschema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: regionAttributeDescription,
+		}
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	inner, meta, diags := w.innerFromValue(ctx, request.Plan.GetAttribute)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
-		w.inner.Create(ctx, request, response)
+		if !w.regionOverrideEnabled {
+			inner.Create(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		var region tftypes.Value
+		innerRequest, innerResponse := request, *response
+		innerRequest.Config, diags = configWithoutRegion(request.Config)
+		if diags.HasError() {
+			return diags
+		}
+		innerRequest.Plan, region, diags = planWithoutRegion(request.Plan)
+		if diags.HasError() {
+			return diags
+		}
+		innerResponse.State, _, diags = stateWithoutRegion(response.State)
+		if diags.HasError() {
+			return diags
+		}
+
+		inner.Create(ctx, innerRequest, &innerResponse)
+
+		outer := response.State
+		*response = innerResponse
+		response.State, diags = stateWithRegion(ctx, innerResponse.State, outer, region)
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedResourceHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
+	diags = interceptedResourceHandler(w.interceptors.create(), f, meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	inner, meta, diags := w.innerFromValue(ctx, request.State.GetAttribute)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		if !w.regionOverrideEnabled {
+			inner.Read(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		var region tftypes.Value
+		innerRequest, innerResponse := request, *response
+		innerRequest.State, region, diags = stateWithoutRegion(request.State)
+		if diags.HasError() {
+			return diags
+		}
+		innerResponse.State, _, diags = stateWithoutRegion(response.State)
+		if diags.HasError() {
+			return diags
+		}
+
+		inner.Read(ctx, innerRequest, &innerResponse)
+
+		outer := response.State
+		*response = innerResponse
+		response.State, diags = stateWithRegion(ctx, innerResponse.State, outer, region)
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedResourceHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+	diags = interceptedResourceHandler(w.interceptors.read(), f, meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	inner, meta, diags := w.innerFromValue(ctx, request.Plan.GetAttribute)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
-		w.inner.Update(ctx, request, response)
+		if !w.regionOverrideEnabled {
+			inner.Update(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		var region tftypes.Value
+		innerRequest, innerResponse := request, *response
+		innerRequest.Config, diags = configWithoutRegion(request.Config)
+		if diags.HasError() {
+			return diags
+		}
+		innerRequest.Plan, region, diags = planWithoutRegion(request.Plan)
+		if diags.HasError() {
+			return diags
+		}
+		innerRequest.State, _, diags = stateWithoutRegion(request.State)
+		if diags.HasError() {
+			return diags
+		}
+		innerResponse.State, _, diags = stateWithoutRegion(response.State)
+		if diags.HasError() {
+			return diags
+		}
+
+		inner.Update(ctx, innerRequest, &innerResponse)
+
+		outer := response.State
+		*response = innerResponse
+		response.State, diags = stateWithRegion(ctx, innerResponse.State, outer, region)
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedResourceHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
+	diags = interceptedResourceHandler(w.interceptors.update(), f, meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	inner, meta, diags := w.innerFromValue(ctx, request.State.GetAttribute)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
-		w.inner.Delete(ctx, request, response)
+		if !w.regionOverrideEnabled {
+			inner.Delete(ctx, request, response)
+			return response.Diagnostics
+		}
+
+		var diags diag.Diagnostics
+		var region tftypes.Value
+		innerRequest, innerResponse := request, *response
+		innerRequest.State, region, diags = stateWithoutRegion(request.State)
+		if diags.HasError() {
+			return diags
+		}
+		innerResponse.State, _, diags = stateWithoutRegion(response.State)
+		if diags.HasError() {
+			return diags
+		}
+
+		inner.Delete(ctx, innerRequest, &innerResponse)
+
+		outer := response.State
+		*response = innerResponse
+		response.State, diags = stateWithRegion(ctx, innerResponse.State, outer, region)
+		response.Diagnostics.Append(diags...)
+
 		return response.Diagnostics
 	}
-	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedResourceHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
+	diags = interceptedResourceHandler(w.interceptors.delete(), f, meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
 
@@ -324,24 +847,206 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 }
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+	_, ok := w.inner.(resource.ResourceWithImportState)
+
+	if !ok && w.identity == nil {
+		response.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
 
 		return
 	}
 
-	response.Diagnostics.AddError(
-		"Resource Import Not Implemented",
-		"This resource does not support import. Please contact the provider developer for additional information.",
-	)
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	var region string
+	if w.regionOverrideEnabled {
+		// Import IDs of the form "<id>@<region>" set the resource's Region.
+		if id, v, ok := conns.ParseImportIDWithRegion(request.ID); ok {
+			request.ID, region = id, v
+		}
+	}
+
+	if v, ok := conns.FromContext(ctx); ok {
+		v.ResourceID = request.ID
+	}
+
+	inner, meta, diags := w.forRegion(ctx, region)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	importState := func(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+		if v, ok := inner.(resource.ResourceWithImportState); ok {
+			v.ImportState(ctx, request, response)
+		} else {
+			identity.ImportState(ctx, w.identity, request, response)
+		}
+	}
+
+	if !w.regionOverrideEnabled {
+		importState(ctx, request, response)
+		return
+	}
+
+	innerResponse := *response
+	innerResponse.State, _, diags = stateWithoutRegion(response.State)
+	if diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	importState(ctx, request, &innerResponse)
+
+	outer := response.State
+	*response = innerResponse
+	response.State, diags = stateWithRegion(ctx, innerResponse.State, outer, tftypes.NewValue(tftypes.String, nil))
+	response.Diagnostics.Append(diags...)
+
+	if w.regionOverrideEnabled && !response.Diagnostics.HasError() {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region)...)
+	}
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if w.regionOverrideEnabled {
+		response.Diagnostics.Append(w.setRegionInPlan(ctx, request, response)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
+
+	if _, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+		inner := w.inner
+		// If the entire plan is null, the resource is planned for destruction.
+		if !request.Plan.Raw.IsNull() {
+			var diags diag.Diagnostics
+			inner, _, diags = w.innerFromValue(ctx, response.Plan.GetAttribute)
+			if diags.HasError() {
+				response.Diagnostics.Append(diags...)
+				return
+			}
+		}
+
+		if w.regionOverrideEnabled {
+			response.Diagnostics.Append(modifyPlanWithoutRegion(ctx, inner.(resource.ResourceWithModifyPlan), request, response)...)
+		} else {
+			inner.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, request, response)
+		}
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	for _, v := range w.interceptors {
+		if v, ok := v.(resourceModifyPlanInterceptor); ok {
+			var diags diag.Diagnostics
+			ctx, diags = v.modifyPlan(ctx, request, response, w.meta, diags)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+}
+
+// modifyPlanWithoutRegion calls the specified inner resource's ModifyPlan method with the `region` attribute removed
+// from the request's configuration, plan and state and from the response's plan.
+func modifyPlanWithoutRegion(ctx context.Context, inner resource.ResourceWithModifyPlan, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var region tftypes.Value
+	innerRequest, innerResponse := request, *response
+	innerRequest.Config, diags = configWithoutRegion(request.Config)
+	if diags.HasError() {
+		return diags
+	}
+	innerRequest.Plan, _, diags = planWithoutRegion(request.Plan)
+	if diags.HasError() {
+		return diags
+	}
+	innerRequest.State, _, diags = stateWithoutRegion(request.State)
+	if diags.HasError() {
+		return diags
+	}
+	innerResponse.Plan, region, diags = planWithoutRegion(response.Plan)
+	if diags.HasError() {
+		return diags
+	}
+	innerResponse.Diagnostics = nil
+
+	inner.ModifyPlan(ctx, innerRequest, &innerResponse)
+
+	outer := response.Plan
+	response.Plan, diags = planWithRegion(ctx, innerResponse.Plan, outer, region)
+	response.RequiresReplace = innerResponse.RequiresReplace
+	response.Private = innerResponse.Private
+	response.Deferred = innerResponse.Deferred
+	diags = append(innerResponse.Diagnostics, diags...)
+
+	return diags
+}
+
+// setRegionInPlan sets the planned value of the resource's `region` attribute to the provider's configured Region
+// if no value is configured. Any configured value is validated.
+// A change of Region requires replacement of the resource.
+func (w *wrappedResource) setRegionInPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() || w.meta == nil {
+		return diags
+	}
+
+	var configRegion, stateRegion fwtypes.String
+	diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !request.State.Raw.IsNull() {
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	var planRegion string
+	switch {
+	case configRegion.IsUnknown():
+		return diags
+	case !configRegion.IsNull():
+		planRegion = configRegion.ValueString()
+		if err := w.meta.ValidateRegion(ctx, planRegion); err != nil {
+			diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region Value", err.Error())
+			return diags
+		}
+	case !request.State.Raw.IsNull() && stateRegion.ValueString() == "":
+		// Don't force replacement of resources created before the `region` attribute was added.
+		// The value is set by the next Read.
+		return diags
+	default:
+		planRegion = w.meta.Region
+	}
+
+	diags.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), planRegion)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !request.State.Raw.IsNull() && stateRegion.ValueString() != planRegion {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+	}
+
+	return diags
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -356,15 +1061,170 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		if w.regionOverrideEnabled {
+			var diags diag.Diagnostics
+			request.Config, diags = configWithoutRegion(request.Config)
+			if diags.HasError() {
+				response.Diagnostics.Append(diags...)
+				return
+			}
+		}
+
 		v.ValidateConfig(ctx, request, response)
 	}
 }
 
+// readOnlyResourceInterceptor prevents resources from being created, updated or deleted if the provider is configured as read-only.
+type readOnlyResourceInterceptor struct{}
+
+func (r readOnlyResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, r.run(ctx, meta, when, "create", diags)
+}
+
+func (r readOnlyResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r readOnlyResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, r.run(ctx, meta, when, "update", diags)
+}
+
+func (r readOnlyResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, r.run(ctx, meta, when, "delete", diags)
+}
+
+func (r readOnlyResourceInterceptor) run(ctx context.Context, meta *conns.AWSClient, when when, operation string, diags diag.Diagnostics) diag.Diagnostics {
+	if meta == nil || !meta.ReadOnly(ctx) {
+		return diags
+	}
+
+	var typeName string
+	if v, ok := conns.FromContext(ctx); ok {
+		typeName = v.TypeName
+	}
+
+	switch when {
+	case Before:
+		diags.AddError(
+			"Provider is read-only",
+			fmt.Sprintf("Cannot %s %s: the provider is configured with read_only = true.", operation, typeName),
+		)
+	}
+
+	return diags
+}
+
+// resourceIDResourceInterceptor records the resource's ID in Context.
+type resourceIDResourceInterceptor struct{}
+
+func (r resourceIDResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r resourceIDResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, request.State, when), diags
+}
+
+func (r resourceIDResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, request.State, when), diags
+}
+
+func (r resourceIDResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, request.State, when), diags
+}
+
+func (r resourceIDResourceInterceptor) run(ctx context.Context, state tfsdk.State, when when) context.Context {
+	switch when {
+	case Before:
+		// Not all resources have an `id` attribute.
+		var id fwtypes.String
+		if diags := state.GetAttribute(ctx, path.Root(names.AttrID), &id); diags.HasError() {
+			return ctx
+		}
+
+		if v, ok := conns.FromContext(ctx); ok {
+			v.ResourceID = id.ValueString()
+		}
+	}
+
+	return ctx
+}
+
+// regionResourceInterceptor sets the `region` attribute in state to the AWS Region used by the resource.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if response.State.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 
-		return v.UpgradeState(ctx)
+		upgraders := v.UpgradeState(ctx)
+		if !w.regionOverrideEnabled {
+			return upgraders
+		}
+
+		for k, upgrader := range upgraders {
+			f := upgrader.StateUpgrader
+			upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				innerResponse := *response
+				var diags diag.Diagnostics
+				innerResponse.State, _, diags = stateWithoutRegion(response.State)
+				if diags.HasError() {
+					response.Diagnostics.Append(diags...)
+					return
+				}
+
+				f(ctx, request, &innerResponse)
+
+				outer := response.State
+				*response = innerResponse
+				if response.DynamicValue != nil {
+					return
+				}
+				// The `region` attribute is set by the next Read.
+				response.State, diags = stateWithRegion(ctx, innerResponse.State, outer, tftypes.NewValue(tftypes.String, nil))
+				response.Diagnostics.Append(diags...)
+			}
+			upgraders[k] = upgrader
+		}
+
+		return upgraders
 	}
 
 	return nil
@@ -374,7 +1234,34 @@ func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 
-		return v.MoveState(ctx)
+		movers := v.MoveState(ctx)
+		if !w.regionOverrideEnabled {
+			return movers
+		}
+
+		for i, mover := range movers {
+			f := mover.StateMover
+			mover.StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+				innerResponse := *response
+				var diags diag.Diagnostics
+				innerResponse.TargetState, _, diags = stateWithoutRegion(response.TargetState)
+				if diags.HasError() {
+					response.Diagnostics.Append(diags...)
+					return
+				}
+
+				f(ctx, request, &innerResponse)
+
+				outer := response.TargetState
+				*response = innerResponse
+				// The `region` attribute is set by the next Read.
+				response.TargetState, diags = stateWithRegion(ctx, innerResponse.TargetState, outer, tftypes.NewValue(tftypes.String, nil))
+				response.Diagnostics.Append(diags...)
+			}
+			movers[i] = mover
+		}
+
+		return movers
 	}
 
 	return nil
@@ -430,6 +1317,54 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 	return ctx, diags
 }
 
+// modifyPlan validates the planned tags of a resource that is being created, or whose tags are changing,
+// against the provider's tag policy.
+func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.tags == nil || meta == nil || meta.TagPolicyConfig == nil {
+		return ctx, diags
+	}
+
+	var planTags fwtypes.Map
+	diags.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	if planTags.IsUnknown() {
+		return ctx, diags
+	}
+	for _, v := range planTags.Elements() {
+		if v.IsUnknown() {
+			return ctx, diags
+		}
+	}
+
+	tags := meta.DefaultTagsConfig.MergeTags(tftags.New(ctx, planTags))
+
+	if !request.State.Raw.IsNull() {
+		var stateTagsAll fwtypes.Map
+		diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		if tags.IgnoreConfig(meta.IgnoreTagsConfig).Equal(tftags.New(ctx, stateTagsAll)) {
+			return ctx, diags
+		}
+	}
+
+	if err := meta.TagPolicyConfig.Validate(tags); err != nil {
+		const summary = "Resource tags don't comply with tag policy"
+		if meta.TagPolicyConfig.IsWarn() {
+			diags.AddAttributeWarning(path.Root(names.AttrTags), summary, err.Error())
+		} else {
+			diags.AddAttributeError(path.Root(names.AttrTags), summary, err.Error())
+		}
+	}
+
+	return ctx, diags
+}
+
 func (r tagsResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	if r.tags == nil {
 		return ctx, diags
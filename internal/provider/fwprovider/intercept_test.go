@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionTestResource is a minimal Plugin Framework resource whose model doesn't declare the `region` attribute.
+type regionTestResource struct{}
+
+type regionTestResourceModel struct {
+	ID   fwtypes.String `tfsdk:"id"`
+	Name fwtypes.String `tfsdk:"name"`
+}
+
+func (r regionTestResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (r regionTestResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r regionTestResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+}
+
+func (r regionTestResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data regionTestResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwtypes.StringValue("id-" + data.Name.ValueString())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r regionTestResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data regionTestResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r regionTestResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data regionTestResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r regionTestResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+}
+
+func TestWrappedResourceRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	meta := &conns.AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+	}
+	bootstrapContext := func(ctx context.Context, _ *conns.AWSClient) context.Context {
+		return ctx
+	}
+	newInner := func(context.Context) (resource.ResourceWithConfigure, error) {
+		return regionTestResource{}, nil
+	}
+
+	w := newWrappedResource(bootstrapContext, regionTestResource{}, resourceInterceptors{regionResourceInterceptor{}}, true, newInner, nil)
+	w.Configure(ctx, resource.ConfigureRequest{ProviderData: meta}, &resource.ConfigureResponse{})
+
+	schemaResponse := resource.SchemaResponse{}
+	w.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+	s := schemaResponse.Schema
+
+	if _, ok := s.Attributes[names.AttrRegion]; !ok {
+		t.Fatalf("no `%s` attribute in schema", names.AttrRegion)
+	}
+
+	typ := s.Type().TerraformType(ctx)
+	value := func(id, region any) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			names.AttrID:     tftypes.NewValue(tftypes.String, id),
+			names.AttrName:   tftypes.NewValue(tftypes.String, "test"),
+			names.AttrRegion: tftypes.NewValue(tftypes.String, region),
+		})
+	}
+
+	// Plan with no configured Region.
+	modifyPlanRequest := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Raw: value(nil, nil), Schema: s},
+		Plan:   tfsdk.Plan{Raw: value(tftypes.UnknownValue, tftypes.UnknownValue), Schema: s},
+		State:  tfsdk.State{Raw: tftypes.NewValue(typ, nil), Schema: s},
+	}
+	modifyPlanResponse := resource.ModifyPlanResponse{Plan: modifyPlanRequest.Plan}
+	w.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, modifyPlanRequest, &modifyPlanResponse)
+
+	if modifyPlanResponse.Diagnostics.HasError() {
+		t.Fatalf("unexpected ModifyPlan error: %v", modifyPlanResponse.Diagnostics)
+	}
+
+	var region fwtypes.String
+	modifyPlanResponse.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)
+	if got, want := region.ValueString(), "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("planned Region = %q, want %q", got, want)
+	}
+
+	// Create with a configured Region.
+	createRequest := resource.CreateRequest{
+		Config: tfsdk.Config{Raw: value(nil, "us-west-2"), Schema: s},                //lintignore:AWSAT003
+		Plan:   tfsdk.Plan{Raw: value(tftypes.UnknownValue, "us-west-2"), Schema: s}, //lintignore:AWSAT003
+	}
+	createResponse := resource.CreateResponse{State: tfsdk.State{Raw: tftypes.NewValue(typ, nil), Schema: s}}
+	w.Create(ctx, createRequest, &createResponse)
+
+	if createResponse.Diagnostics.HasError() {
+		t.Fatalf("unexpected Create error: %v", createResponse.Diagnostics)
+	}
+
+	if got, want := createResponse.State.Raw, value("id-test", "us-west-2"); !got.Equal(want) { //lintignore:AWSAT003
+		t.Errorf("Create state = %s, want %s", got, want)
+	}
+
+	// Read state written before the `region` attribute was added.
+	readRequest := resource.ReadRequest{State: tfsdk.State{Raw: value("id-test", nil), Schema: s}}
+	readResponse := resource.ReadResponse{State: readRequest.State}
+	w.Read(ctx, readRequest, &readResponse)
+
+	if readResponse.Diagnostics.HasError() {
+		t.Fatalf("unexpected Read error: %v", readResponse.Diagnostics)
+	}
+
+	if got, want := readResponse.State.Raw, value("id-test", "us-west-2"); !got.Equal(want) { //lintignore:AWSAT003
+		t.Errorf("Read state = %s, want %s", got, want)
+	}
+}
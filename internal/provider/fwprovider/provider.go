@@ -10,22 +10,28 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rsschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
+var _ provider.ProviderWithEphemeralResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
@@ -56,6 +62,30 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"allowed_organization_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "AWS Organization IDs, one of which the AWS account must be a member of. Verified using the AWS Organizations DescribeAccount API.",
+			},
+			"allowed_organization_paths": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "AWS Organizations paths, for example `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/`, one of which must be a prefix of the AWS account's path. Verified using the AWS Organizations DescribeAccount and ListParents APIs.",
+			},
+			"allowed_regions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "AWS Regions in which the provider, and any resource or data source, may operate.",
+			},
+			"allowed_stateful_resource_changes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Stateful resource types, for example `aws_s3_bucket`, that may be replaced or deleted when protect_stateful_resources is true.",
+			},
+			"audit_log_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a JSON Lines record is appended for each AWS API call that is not a read.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -96,6 +126,14 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"protect_stateful_resources": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether replacement or deletion of stateful resources, such as databases and storage, is prevented.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether resources are prevented from being created, updated or deleted. Use for plan-only runs with production credentials.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
@@ -166,6 +204,28 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"api_recording": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to record AWS API interactions to, or replay them from, disk.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"mode": schema.StringAttribute{
+							Required:    true,
+							Description: "Whether to `record` AWS API interactions or `replay` previously recorded interactions.",
+						},
+						names.AttrName: schema.StringAttribute{
+							Optional:    true,
+							Description: "Prefix of the cassette file names. Defaults to `aws`.",
+						},
+						names.AttrPath: schema.StringAttribute{
+							Required:    true,
+							Description: "Directory containing the cassettes.",
+						},
+					},
+				},
+			},
 			"assume_role": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -288,6 +348,109 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"retry": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings for retrying AWS API requests.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"budget": schema.StringAttribute{
+							Optional:    true,
+							Description: "The maximum time to spend on an AWS API call, including retries, for example `10m`. No retries are attempted once it has elapsed.",
+						},
+						"max_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "The maximum delay between attempts of an AWS API request, for example `30s`. Defaults to `300s`.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"retryable_error": schema.ListNestedBlock{
+							Description: "Configuration blocks with AWS API errors to retry in addition to those retried by default.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"error_codes": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "AWS API error codes to retry.",
+									},
+									"error_messages": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Substrings of AWS API error messages to retry.",
+									},
+									"services": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "The services, for example `route53` or `iam`, to retry the errors for. Defaults to all services.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_rate_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to limit the rate of AWS API requests made for a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_in_flight": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of concurrent AWS API requests for the service.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum sustained rate of AWS API requests per second for the service.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, for example `route53` or `iam`.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to enforce constraints on resource tags across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"mode": schema.StringAttribute{
+							Optional: true,
+							Description: "Whether resources whose tags don't comply with the tag policy cause an error or a warning. " +
+								"Valid values are `error` and `warn`. Defaults to `error`.",
+						},
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys required on all resources.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"tag": schema.ListNestedBlock{
+							Description: "Configuration blocks with constraints on the value of a resource tag.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Allowed values of the resource tag.",
+									},
+									"key": schema.StringAttribute{
+										Required:    true,
+										Description: "Resource tag key.",
+									},
+									"value_pattern": schema.StringAttribute{
+										Optional:    true,
+										Description: "Regular expression that values of the resource tag must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -299,6 +462,7 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	// Provider's parsed configuration (its instance state) is available through the primary provider's Meta() method.
 	v := p.Primary.Meta()
 	response.DataSourceData = v
+	response.EphemeralResourceData = v
 	response.ResourceData = v
 }
 
@@ -362,8 +526,22 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				interceptors = append(interceptors, tagsDataSourceInterceptor{tags: v.Tags})
 			}
 
+			var isRegionOverrideEnabled bool
+
+			if regionOverrideEnabled(v.Region) {
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				// The data source supports per-resource Region override.
+				// Data sources that already define a `region` attribute are skipped.
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+					interceptors = append(interceptors, regionDataSourceInterceptor{})
+					isRegionOverrideEnabled = true
+				}
+			}
+
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, isRegionOverrideEnabled, v.Factory)
 			})
 		}
 	}
@@ -404,7 +582,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
@@ -412,7 +590,10 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := resourceInterceptors{
+				readOnlyResourceInterceptor{},
+				resourceIDResourceInterceptor{},
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -442,8 +623,34 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if v := v.Identity; v != nil {
+				// The resource has declared its identity.
+				// Ensure that the schema look OK.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if err := validateResourceIdentity(ctx, schemaResponse.Schema, v); err != nil {
+					errs = append(errs, fmt.Errorf("invalid identity: %s: %w", typeName, err))
+					continue
+				}
+			}
+
+			var isRegionOverrideEnabled bool
+
+			if regionOverrideEnabled(v.Region) {
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				// The resource supports per-resource Region override.
+				// Resources that already define a `region` attribute are skipped.
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+					interceptors = append(interceptors, regionResourceInterceptor{})
+					isRegionOverrideEnabled = true
+				}
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, isRegionOverrideEnabled, v.Factory, v.Identity)
 			})
 		}
 	}
@@ -457,6 +664,57 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// EphemeralResources returns a slice of functions to instantiate each EphemeralResource
+// implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource implementing
+// the Metadata method. All ephemeral resources must have unique names.
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var errs []error
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		sp, ok := sp.(conns.ServicePackageWithEphemeralResources)
+		if !ok {
+			continue
+		}
+
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range sp.EphemeralResources(ctx) {
+			v := v
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("creating ephemeral resource: %w", err))
+				continue
+			}
+
+			// bootstrapContext is run on all wrapped methods.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+				if meta != nil {
+					ctx = meta.RegisterLogger(ctx)
+				}
+
+				return ctx
+			}
+
+			ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
+				return newWrappedEphemeralResource(bootstrapContext, inner)
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering ephemeral resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return ephemeralResources
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -465,8 +723,14 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
+		tffunction.NewARNMatchesFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRSplitSubnetsFunction,
+		tffunction.NewPolicyEquivalentFunction,
+		tffunction.NewPolicyMergeFunction,
+		tffunction.NewS3URIParseFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewUserAgentFunction,
 	}
 }
 
@@ -486,3 +750,31 @@ func endpointsBlock() schema.SetNestedBlock {
 		},
 	}
 }
+
+// validateResourceIdentity returns an error if the specified identity is not well formed
+// or if any of its ID attributes is not a string attribute in the resource's schema.
+func validateResourceIdentity(ctx context.Context, s rsschema.Schema, v *itypes.ServicePackageResourceIdentity) error {
+	if err := identity.Validate(v); err != nil {
+		return err
+	}
+
+	for _, attr := range v.IDAttributes {
+		if v, ok := s.Attributes[attr]; !ok {
+			return fmt.Errorf("ID attribute (%s) not found in schema", attr)
+		} else if !v.GetType().TerraformType(ctx).Is(tftypes.String) {
+			return fmt.Errorf("ID attribute (%s) is not a string", attr)
+		}
+	}
+
+	return nil
+}
+
+// regionOverrideEnabled returns whether or not a Plugin Framework resource or data source supports per-resource Region override.
+// Resources and data sources without Region information are assumed to be regional and to support override.
+func regionOverrideEnabled(v *itypes.ServicePackageResourceRegion) bool {
+	if v == nil {
+		return true
+	}
+
+	return v.IsOverrideEnabled
+}
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
@@ -89,10 +90,14 @@ func (s interceptorItems) why(why why) interceptorItems {
 }
 
 // interceptedHandler returns a handler that invokes the specified CRUD handler, running any interceptors.
-func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, interceptors interceptorItems, f F, why why) F {
+func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](bootstrapContext contextFunc, metaFunc metaFunc, interceptors interceptorItems, f F, why why) F {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+		meta, err := metaFunc(ctx, d.Get, meta)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -137,15 +142,39 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 // contextFunc augments Context.
 type contextFunc func(context.Context, any) context.Context
 
+// metaFunc returns the provider Meta (instance data) to be used by a wrapped method.
+// The supplied function returns the value of a resource or data source attribute.
+type metaFunc func(context.Context, func(string) any, any) (any, error)
+
+// identityMeta is a metaFunc that returns the provider Meta unchanged.
+func identityMeta(_ context.Context, _ func(string) any, meta any) (any, error) {
+	return meta, nil
+}
+
+// regionalMeta is a metaFunc that returns the provider Meta for the AWS Region
+// specified in the resource or data source's `region` attribute.
+func regionalMeta(ctx context.Context, get func(string) any, meta any) (any, error) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return meta, nil
+	}
+
+	region, _ := get(names.AttrRegion).(string)
+
+	return c.ForRegion(ctx, region)
+}
+
 // wrappedDataSource represents an interceptor dispatcher for a Plugin SDK v2 data source.
 type wrappedDataSource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// meta is run on all wrapped methods after bootstrapContext and before any interceptors.
+	meta metaFunc
 }
 
 func (ds *wrappedDataSource) Read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedHandler(ds.bootstrapContext, ds.interceptors, f, Read)
+	return interceptedHandler(ds.bootstrapContext, ds.meta, ds.interceptors, f, Read)
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin SDK v2 resource.
@@ -153,48 +182,225 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// meta is run on all wrapped methods after bootstrapContext and before any interceptors.
+	meta                  metaFunc
+	regionOverrideEnabled bool
+	tagsEnabled           bool
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Create)
+	return interceptedHandler(r.bootstrapContext, r.meta, r.interceptors, f, Create)
 }
 
 func (r *wrappedResource) Read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Read)
+	return interceptedHandler(r.bootstrapContext, r.meta, r.interceptors, f, Read)
 }
 
 func (r *wrappedResource) Update(f schema.UpdateContextFunc) schema.UpdateContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Update)
+	return interceptedHandler(r.bootstrapContext, r.meta, r.interceptors, f, Update)
 }
 
 func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Delete)
+	return interceptedHandler(r.bootstrapContext, r.meta, r.interceptors, f, Delete)
 }
 
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		if r.regionOverrideEnabled {
+			// Import IDs of the form "<id>@<region>" set the resource's Region.
+			if id, region, ok := conns.ParseImportIDWithRegion(d.Id()); ok {
+				d.SetId(id)
+				if err := d.Set(names.AttrRegion, region); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if v, ok := conns.FromContext(ctx); ok {
+			v.ResourceID = d.Id()
+		}
+
+		meta, err := r.meta(ctx, d.Get, meta)
+		if err != nil {
+			return nil, err
+		}
+
 		return f(ctx, d, meta)
 	}
 }
 
+// CustomizeDiff wraps the specified CustomizeDiffFunc, which may be nil.
 func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
 
-		return f(ctx, d, meta)
+		if r.regionOverrideEnabled {
+			// Must be run with the provider-level Meta.
+			if err := setRegionInPlan(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		if f != nil {
+			meta, err := r.meta(ctx, d.Get, meta)
+			if err != nil {
+				return err
+			}
+
+			if err := f(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		if r.tagsEnabled {
+			if err := validateTagPolicyInPlan(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		return nil
 	}
 }
 
 func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
 	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
 		ctx = r.bootstrapContext(ctx, meta)
+		meta, err := r.meta(ctx, func(k string) any { return rawState[k] }, meta)
+		if err != nil {
+			return nil, err
+		}
 
 		return f(ctx, rawState, meta)
 	}
 }
 
+// setRegionInPlan sets the planned value of a resource's `region` attribute to the provider's configured Region
+// if no value is configured. Any configured value is validated.
+func setRegionInPlan(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return nil
+	}
+
+	if v := d.GetRawConfig().GetAttr(names.AttrRegion); v.IsKnown() && !v.IsNull() {
+		return c.ValidateRegion(ctx, v.AsString())
+	}
+
+	// Don't force replacement of resources created before the `region` attribute was added.
+	// The value is set by the next Read.
+	if o, _ := d.GetChange(names.AttrRegion); d.Id() != "" && o.(string) == "" {
+		return nil
+	}
+
+	return d.SetNew(names.AttrRegion, c.Region)
+}
+
+// validateTagPolicyInPlan validates the planned tags of a resource that is being created, or whose tags are changing,
+// against the provider's tag policy.
+// A CustomizeDiff function cannot return warnings so violations in warn mode are reported by tagPolicyResourceServer.
+func validateTagPolicyInPlan(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || c.TagPolicyConfig == nil || c.TagPolicyConfig.IsWarn() {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges(names.AttrTags, names.AttrTagsAll) {
+		return nil
+	}
+
+	if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+		return nil
+	}
+
+	tags := c.DefaultTagsConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
+
+	if err := c.TagPolicyConfig.Validate(tags); err != nil {
+		return fmt.Errorf("resource tags don't comply with tag policy: %w", err)
+	}
+
+	return nil
+}
+
+// readOnlyInterceptor prevents resources from being created, updated or deleted if the provider is configured as read-only.
+type readOnlyInterceptor struct{}
+
+func (r readOnlyInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || !c.ReadOnly(ctx) {
+		return ctx, diags
+	}
+
+	var operation string
+	switch why {
+	case Create:
+		operation = "create"
+	case Update:
+		operation = "update"
+	case Delete:
+		operation = "delete"
+	default:
+		return ctx, diags
+	}
+
+	var typeName string
+	if v, ok := conns.FromContext(ctx); ok {
+		typeName = v.TypeName
+	}
+
+	switch when {
+	case Before:
+		diags = append(diags, errs.NewErrorDiagnostic(
+			"Provider is read-only",
+			fmt.Sprintf("Cannot %s %s: the provider is configured with read_only = true.", operation, typeName),
+		))
+	}
+
+	return ctx, diags
+}
+
+// resourceIDInterceptor records the resource's ID in Context.
+type resourceIDInterceptor struct{}
+
+func (r resourceIDInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		if v, ok := conns.FromContext(ctx); ok {
+			v.ResourceID = d.Id()
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionInterceptor sets the `region` attribute in state to the AWS Region used by the CRUD handler.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			if err := d.Set(names.AttrRegion, c.Region); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
 type tagsCRUDFunc func(context.Context, schemaResourceData, conns.ServicePackage, *types.ServicePackageResourceTags, string, string, any, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
 // tagsResourceInterceptor implements transparent tagging for resources.
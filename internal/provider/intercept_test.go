@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
@@ -88,8 +89,42 @@ func TestInterceptedHandler(t *testing.T) {
 		return ctx
 	}
 
-	diags := interceptedHandler(bootstrapContext, interceptors, read, Read)(context.Background(), nil, 42)
+	diags := interceptedHandler(bootstrapContext, identityMeta, interceptors, read, Read)(context.Background(), nil, 42)
 	if got, want := len(diags), 1; got != want {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestResourceIDInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptors := interceptorItems{
+		{
+			when:        Before,
+			why:         Read | Update | Delete,
+			interceptor: resourceIDInterceptor{},
+		},
+	}
+
+	var got string
+	var del schema.DeleteContextFunc = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		if v, ok := conns.FromContext(ctx); ok {
+			got = v.ResourceID
+		}
+		return nil
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return conns.NewResourceContext(ctx, "ec2", "VPC", "aws_vpc")
+	}
+
+	d := (&schema.Resource{}).TestResourceData()
+	d.SetId("vpc-12345678")
+
+	if diags := interceptedHandler(bootstrapContext, identityMeta, interceptors, del, Delete)(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if want := "vpc-12345678"; got != want {
+		t.Errorf("resource ID = %q, want %q", got, want)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -24,8 +25,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -49,8 +52,42 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"allowed_organization_ids": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "AWS Organization IDs, one of which the AWS account must be a member of. Verified using the AWS Organizations DescribeAccount API.",
+			},
+			"allowed_organization_paths": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Description: "AWS Organizations paths, for example `o-a1b2c3d4e5/r-ab12/ou-ab12-11111111/`, " +
+					"one of which must be a prefix of the AWS account's path. " +
+					"Verified using the AWS Organizations DescribeAccount and ListParents APIs.",
+			},
+			"allowed_regions": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "AWS Regions in which the provider, and any resource or data source, may operate.",
+			},
+			"allowed_stateful_resource_changes": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Description: "Stateful resource types, for example `aws_s3_bucket`, " +
+					"that may be replaced or deleted when protect_stateful_resources is true.",
+			},
+			"api_recording":                 apiRecordingSchema(),
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a file to which a JSON Lines record is appended for each AWS API call " +
+					"that is not a read.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -152,12 +189,25 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"protect_stateful_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether replacement or deletion of stateful resources, " +
+					"such as databases and storage, is prevented.",
+			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether resources are prevented from being created, updated or deleted. " +
+					"Use for plan-only runs with production credentials.",
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"retry": retrySchema(),
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -185,6 +235,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_rate_limits": serviceRateLimitsSchema(),
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -228,6 +279,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": tagPolicySchema(),
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -322,9 +374,30 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			var meta metaFunc = identityMeta
+
+			if regionOverrideEnabled(v.Region) {
+				schema := r.SchemaMap()
+
+				// The data source has opted in to per-resource Region override.
+				// Data sources that already define a `region` attribute are skipped.
+				if _, ok := schema[names.AttrRegion]; !ok {
+					attr := regionSchema
+					addRegionAttribute(r, &attr)
+
+					interceptors = append(interceptors, interceptorItem{
+						when:        After,
+						why:         Read,
+						interceptor: regionInterceptor{},
+					})
+					meta = regionalMeta
+				}
+			}
+
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
+				meta:             meta,
 			}
 
 			if v := r.ReadWithoutTimeout; v != nil {
@@ -363,9 +436,23 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				continue
 			}
 
+			// Resources that declare an identity get a generated importer unless they implement their own.
+			if v := v.Identity; v != nil {
+				if err := validateSDKResourceIdentity(r, v); err != nil {
+					errs = append(errs, fmt.Errorf("invalid identity: %s: %w", typeName, err))
+					continue
+				}
+
+				if r.Importer == nil {
+					r.Importer = &schema.ResourceImporter{
+						StateContext: identity.ImportStateContext(v),
+					}
+				}
+			}
+
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
@@ -373,7 +460,19 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        Before,
+					why:         Create | Update | Delete,
+					interceptor: readOnlyInterceptor{},
+				},
+				{
+					when:        Before,
+					why:         Read | Update | Delete,
+					interceptor: resourceIDInterceptor{},
+				},
+			}
+			var isTagsEnabled bool
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -408,11 +507,38 @@ func New(ctx context.Context) (*schema.Provider, error) {
 						readFunc:   tagsReadFunc,
 					},
 				})
+				isTagsEnabled = true
+			}
+
+			var meta metaFunc = identityMeta
+			var isRegionOverrideEnabled bool
+
+			if regionOverrideEnabled(v.Region) {
+				schema := r.SchemaMap()
+
+				// The resource has opted in to per-resource Region override.
+				// Resources that already define a `region` attribute are skipped.
+				if _, ok := schema[names.AttrRegion]; !ok {
+					attr := regionSchema
+					attr.ForceNew = true
+					addRegionAttribute(r, &attr)
+
+					interceptors = append(interceptors, interceptorItem{
+						when:        After,
+						why:         Create | Read | Update,
+						interceptor: regionInterceptor{},
+					})
+					meta = regionalMeta
+					isRegionOverrideEnabled = true
+				}
 			}
 
 			rs := &wrappedResource{
-				bootstrapContext: bootstrapContext,
-				interceptors:     interceptors,
+				bootstrapContext:      bootstrapContext,
+				interceptors:          interceptors,
+				meta:                  meta,
+				regionOverrideEnabled: isRegionOverrideEnabled,
+				tagsEnabled:           isTagsEnabled,
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -432,7 +558,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					r.Importer.StateContext = rs.State(v)
 				}
 			}
-			if v := r.CustomizeDiff; v != nil {
+			if v := r.CustomizeDiff; v != nil || isRegionOverrideEnabled || isTagsEnabled {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
 			for _, stateUpgrader := range r.StateUpgraders {
@@ -464,6 +590,63 @@ func New(ctx context.Context) (*schema.Provider, error) {
 	return provider, nil
 }
 
+// ServicePackages returns the service packages that implement the provider's resources, data sources and functions.
+func ServicePackages(ctx context.Context) []conns.ServicePackage {
+	return servicePackages(ctx)
+}
+
+// validateSDKResourceIdentity returns an error if the specified identity is not well formed
+// or if any of its ID attributes is not a string attribute in the resource's schema.
+func validateSDKResourceIdentity(r *schema.Resource, v *types.ServicePackageResourceIdentity) error {
+	if err := identity.Validate(v); err != nil {
+		return err
+	}
+
+	schemaMap := r.SchemaMap()
+
+	for _, attr := range v.IDAttributes {
+		if s, ok := schemaMap[attr]; !ok {
+			return fmt.Errorf("ID attribute (%s) not found in schema", attr)
+		} else if s.Type != schema.TypeString {
+			return fmt.Errorf("ID attribute (%s) is not a string", attr)
+		}
+	}
+
+	return nil
+}
+
+// regionSchema is the schema of the `region` attribute added to resources and data sources that support per-resource Region override.
+var regionSchema = schema.Schema{
+	Type:        schema.TypeString,
+	Optional:    true,
+	Computed:    true,
+	Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+}
+
+// regionOverrideEnabled returns whether or not a Plugin SDK v2 resource or data source supports per-resource Region override.
+// Resources and data sources without Region information are assumed to be regional and to support override.
+func regionOverrideEnabled(v *types.ServicePackageResourceRegion) bool {
+	if v == nil {
+		return true
+	}
+
+	return v.IsOverrideEnabled
+}
+
+// addRegionAttribute adds the specified `region` attribute to a Plugin SDK v2 resource or data source's schema.
+func addRegionAttribute(r *schema.Resource, attr *schema.Schema) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			schema := f()
+			schema[names.AttrRegion] = attr
+
+			return schema
+		}
+	} else {
+		r.Schema[names.AttrRegion] = attr
+	}
+}
+
 // configure ensures that the provider is fully configured.
 func configure(ctx context.Context, provider *schema.Provider, d *schema.ResourceData) (*conns.AWSClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -477,6 +660,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AuditLogFile:                   d.Get("audit_log_file").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -484,6 +668,8 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
+		ProtectStatefulResources:       d.Get("protect_stateful_resources").(bool),
+		ReadOnly:                       d.Get("read_only").(bool),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
@@ -514,6 +700,30 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("allowed_organization_ids"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedOrganizationIDs = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_organization_paths"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedOrganizationPaths = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("allowed_stateful_resource_changes"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedStatefulResourceChanges = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("api_recording"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.APIRecording = expandAPIRecording(ctx, v.([]interface{})[0].(map[string]interface{}))
+		tflog.Info(ctx, "api_recording configuration set", map[string]any{
+			"tf_aws.api_recording.mode": config.APIRecording.Mode,
+			"tf_aws.api_recording.path": config.APIRecording.Path,
+		})
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
 		tflog.Info(ctx, "assume_role configuration set", map[string]any{
@@ -571,6 +781,19 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("retry"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.Retry = expandRetry(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("service_rate_limits"); ok && len(v.([]interface{})) > 0 {
+		serviceRateLimits, dx := expandServiceRateLimits(ctx, v.([]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceRateLimits = serviceRateLimits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -587,6 +810,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		}
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tagPolicyConfig, dx := expandTagPolicy(ctx, v.([]interface{})[0].(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.TagPolicyConfig = tagPolicyConfig
+	}
+
 	var meta *conns.AWSClient
 	if v, ok := provider.Meta().(*conns.AWSClient); ok {
 		meta = v
@@ -603,6 +835,35 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	return meta, diags
 }
 
+func apiRecordingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to record AWS API interactions to, or replay them from, disk.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"mode": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Whether to `record` AWS API interactions or `replay` previously recorded interactions.",
+					ValidateFunc: validation.StringInSlice(conns.APIRecordingMode_Values(), false),
+				},
+				names.AttrName: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Prefix of the cassette file names. Defaults to `aws`.",
+				},
+				names.AttrPath: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Directory containing the cassettes.",
+				},
+			},
+		},
+	}
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -731,6 +992,143 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings for retrying AWS API requests.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"budget": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The maximum time to spend on an AWS API call, including retries, for example `10m`. No retries are attempted once it has elapsed.",
+					ValidateFunc: verify.ValidDuration,
+				},
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The maximum delay between attempts of an AWS API request, for example `30s`. Defaults to `300s`.",
+					ValidateFunc: verify.ValidDuration,
+				},
+				"retryable_error": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with AWS API errors to retry in addition to those retried by default.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"error_codes": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Description: "AWS API error codes to retry.",
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"error_messages": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Description: "Substrings of AWS API error messages to retry.",
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"services": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Description: "The services, for example `route53` or `iam`, to retry the errors for. Defaults to all services.",
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func serviceRateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to limit the rate of AWS API requests made for a service.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_in_flight": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of concurrent AWS API requests for the service.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "The maximum sustained rate of AWS API requests per second for the service.",
+					ValidateFunc: validation.FloatAtLeast(0.01),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service, for example `route53` or `iam`.",
+					ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+				},
+			},
+		},
+	}
+}
+
+func tagPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to enforce constraints on resource tags across all resources.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"mode": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Whether resources whose tags don't comply with the tag policy cause an error or a warning. " +
+						"Valid values are `error` and `warn`. Defaults to `error`.",
+					ValidateFunc: validation.StringInSlice(tftags.PolicyMode_Values(), false),
+				},
+				"required_keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Resource tag keys required on all resources.",
+				},
+				"tag": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with constraints on the value of a resource tag.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allowed_values": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Allowed values of the resource tag.",
+							},
+							"key": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Resource tag key.",
+							},
+							"value_pattern": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "Regular expression that values of the resource tag must match.",
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -752,6 +1150,28 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandAPIRecording(_ context.Context, tfMap map[string]interface{}) *conns.APIRecordingConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiRecording := conns.APIRecordingConfig{}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiRecording.Mode = v
+	}
+
+	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+		apiRecording.Name = v
+	}
+
+	if v, ok := tfMap[names.AttrPath].(string); ok && v != "" {
+		apiRecording.Path = v
+	}
+
+	return &apiRecording
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -870,6 +1290,151 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandRetry(_ context.Context, tfMap map[string]interface{}) *conns.RetryConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	retryConfig := &conns.RetryConfig{}
+
+	if v, ok := tfMap["budget"].(string); ok && v != "" {
+		duration, _ := time.ParseDuration(v)
+		retryConfig.Budget = duration
+	}
+
+	if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+		duration, _ := time.ParseDuration(v)
+		retryConfig.MaxBackoff = duration
+	}
+
+	if v, ok := tfMap["retryable_error"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			var retryableErrors conns.RetryableErrors
+
+			if v, ok := tfMap["error_codes"].(*schema.Set); ok && v.Len() > 0 {
+				retryableErrors.ErrorCodes = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["error_messages"].(*schema.Set); ok && v.Len() > 0 {
+				retryableErrors.ErrorMessages = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["services"].(*schema.Set); ok && v.Len() > 0 {
+				retryableErrors.Services = flex.ExpandStringValueSet(v)
+			}
+
+			retryConfig.Retryables = append(retryConfig.Retryables, retryableErrors)
+		}
+	}
+
+	return retryConfig
+}
+
+func expandServiceRateLimits(_ context.Context, tfList []interface{}) (map[string]conns.ServiceRateLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceRateLimits := make(map[string]conns.ServiceRateLimit)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+		if _, ok := serviceRateLimits[service]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				cty.GetAttrPath("service_rate_limits"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Duplicate rate limits for service %q", service),
+			))
+			continue
+		}
+
+		var serviceRateLimit conns.ServiceRateLimit
+
+		if v, ok := tfMap["max_in_flight"].(int); ok && v > 0 {
+			serviceRateLimit.MaxInFlight = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok && v > 0 {
+			serviceRateLimit.RequestsPerSecond = v
+		}
+
+		serviceRateLimits[service] = serviceRateLimit
+	}
+
+	return serviceRateLimits, diags
+}
+
+func expandTagPolicy(_ context.Context, tfMap map[string]interface{}) (*tftags.PolicyConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if tfMap == nil {
+		return nil, diags
+	}
+
+	policyConfig := &tftags.PolicyConfig{
+		Mode:  tftags.PolicyModeError,
+		Rules: make(map[string]tftags.PolicyRule),
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		policyConfig.Mode = v
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["tag"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			key := tfMap["key"].(string)
+			if _, ok := policyConfig.Rules[key]; ok {
+				diags = append(diags, errs.NewAttributeErrorDiagnostic(
+					cty.GetAttrPath("tag_policy").IndexInt(0).GetAttr("tag"),
+					"Invalid Attribute Value",
+					fmt.Sprintf("Duplicate constraints for tag %q", key),
+				))
+				continue
+			}
+
+			var rule tftags.PolicyRule
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+				rule.AllowedValues = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["value_pattern"].(string); ok && v != "" {
+				re, err := regexp.Compile(v)
+				if err != nil {
+					diags = append(diags, errs.NewAttributeErrorDiagnostic(
+						cty.GetAttrPath("tag_policy").IndexInt(0).GetAttr("tag"),
+						"Invalid Attribute Value",
+						fmt.Sprintf("Invalid value_pattern for tag %q: %s", key, err),
+					))
+					continue
+				}
+				rule.ValuePattern = re
+			}
+
+			policyConfig.Rules[key] = rule
+		}
+	}
+
+	return policyConfig, diags
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go/aws"
@@ -351,6 +352,116 @@ func TestAccProvider_IgnoreTagsKeys_multiple(t *testing.T) {
 	})
 }
 
+func TestAccProvider_TagPolicy_requiredKeys(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_tagPolicyRequiredKeys(false),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`required tag "Owner" is missing`),
+			},
+			{
+				Config:             testAccProviderConfig_tagPolicyRequiredKeys(true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccProvider_TagPolicy_warn(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccProviderConfig_tagPolicyWarn("test"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccProvider_readOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_readOnly(),
+				ExpectError: regexache.MustCompile(`Cannot create aws_vpc: the provider is configured with read_only = true`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_protectStatefulResources(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_protectStatefulResources("ENCRYPT_DECRYPT", ""),
+			},
+			{
+				Config:      testAccProviderConfig_protectStatefulResources("SIGN_VERIFY", ""),
+				ExpectError: regexache.MustCompile(`Stateful resource replacement prevented`),
+			},
+			{
+				Config:             testAccProviderConfig_protectStatefulResources("SIGN_VERIFY", "aws_kms_key"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Allow the key to be deleted.
+			{
+				Config: testAccProviderConfig_protectStatefulResources("ENCRYPT_DECRYPT", "aws_kms_key"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_allowedRegions(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_allowedRegions(acctest.AlternateRegion()),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`AWS Region not allowed`),
+			},
+			{
+				Config:             testAccProviderConfig_allowedRegions(acctest.Region()),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccProvider_Region_c2s(t *testing.T) {
 	ctx := acctest.Context(t)
 	var provider *schema.Provider
@@ -1125,6 +1236,100 @@ provider "aws" {
 `)
 }
 
+func testAccProviderConfig_tagPolicyRequiredKeys(defaultTags bool) string {
+	var defaultTagsBlock string
+	if defaultTags {
+		defaultTagsBlock = `
+  default_tags {
+    tags = {
+      Owner = "test"
+    }
+  }
+`
+	}
+
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+%[1]s
+  tag_policy {
+    required_keys = ["Owner"]
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+`, defaultTagsBlock)
+}
+
+func testAccProviderConfig_tagPolicyWarn(environment string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  tag_policy {
+    mode = "warn"
+
+    tag {
+      key            = "Environment"
+      allowed_values = ["dev", "prod"]
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Environment = %[1]q
+  }
+}
+`, environment)
+}
+
+func testAccProviderConfig_readOnly() string {
+	//lintignore:AT004
+	return `
+provider "aws" {
+  read_only = true
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+`
+}
+
+func testAccProviderConfig_protectStatefulResources(keyUsage, allowed string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  protect_stateful_resources = true
+
+  allowed_stateful_resource_changes = compact([%[2]q])
+}
+
+resource "aws_kms_key" "test" {
+  key_usage                = %[1]q
+  customer_master_key_spec = %[1]q == "SIGN_VERIFY" ? "ECC_NIST_P256" : "SYMMETRIC_DEFAULT"
+  deletion_window_in_days  = 7
+}
+`, keyUsage, allowed)
+}
+
+func testAccProviderConfig_allowedRegions(region string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  allowed_regions = [%[1]q]
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+}
+`, region)
+}
+
 func testAccProviderConfig_region(region string) string {
 	//lintignore:AT004
 	return acctest.ConfigCompose(testAccProviderConfig_base, fmt.Sprintf(`
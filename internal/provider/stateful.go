@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// statefulResourceServer wraps a protocol version 5 provider server, warning of the planned replacement of stateful resources
+// and, if the resource type is protected, preventing planned replacement or deletion.
+// Stateful resources are those annotated with `@Stateful`.
+// The checks are made on the PlanResourceChange response as that is the only place where all the attributes
+// that require replacement are known, for both Plugin SDK and Framework resources.
+// Plugin SDK CustomizeDiff functions cannot return warnings and are not called for destroy plans.
+type statefulResourceServer struct {
+	tfprotov5.ProviderServer
+
+	// protected returns whether replacement or deletion of the specified resource type is prevented.
+	protected func(context.Context, string) bool
+	typeNames map[string]struct{}
+}
+
+func newStatefulResourceServer(server tfprotov5.ProviderServer, typeNames []string, protected func(context.Context, string) bool) *statefulResourceServer {
+	s := &statefulResourceServer{
+		ProviderServer: server,
+		protected:      protected,
+		typeNames:      make(map[string]struct{}, len(typeNames)),
+	}
+
+	for _, typeName := range typeNames {
+		s.typeNames[typeName] = struct{}{}
+	}
+
+	return s
+}
+
+func (s *statefulResourceServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	if _, ok := s.typeNames[request.TypeName]; !ok {
+		return response, nil
+	}
+
+	for _, v := range response.Diagnostics {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			return response, nil
+		}
+	}
+
+	diags, err := s.validatePlan(ctx, request, response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response.Diagnostics = append(response.Diagnostics, diags...)
+
+	return response, nil
+}
+
+// validatePlan returns diagnostics for a planned replacement or deletion of a stateful resource.
+func (s *statefulResourceServer) validatePlan(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest, response *tfprotov5.PlanResourceChangeResponse) ([]*tfprotov5.Diagnostic, error) {
+	var diags []*tfprotov5.Diagnostic
+
+	// Nothing is lost when a resource is created.
+	if isNull, err := dynamicValueIsNull(request.PriorState); err != nil {
+		return nil, fmt.Errorf("determining if %s is being created: %w", request.TypeName, err)
+	} else if isNull {
+		return diags, nil
+	}
+
+	isDestroy, err := dynamicValueIsNull(request.ProposedNewState)
+	if err != nil {
+		return nil, fmt.Errorf("determining if %s is being deleted: %w", request.TypeName, err)
+	}
+
+	protected := s.protected(ctx, request.TypeName)
+	override := fmt.Sprintf("The provider is configured with protect_stateful_resources = true. "+
+		"To allow the change, add %q to the provider's allowed_stateful_resource_changes.", request.TypeName)
+
+	switch {
+	case isDestroy:
+		if protected {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Stateful resource deletion prevented",
+				Detail:   fmt.Sprintf("Deleting this %s also deletes any data it holds.\n\n%s", request.TypeName, override),
+			})
+		}
+
+	case len(response.RequiresReplace) > 0:
+		detail := fmt.Sprintf("Changes to the following attributes force replacement of this %s: %s.\n\n"+
+			"Replacement deletes the existing resource and any data it holds.",
+			request.TypeName, strings.Join(attributePathStrings(response.RequiresReplace), ", "))
+
+		if protected {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Stateful resource replacement prevented",
+				Detail:   detail + "\n\n" + override,
+			})
+		} else {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityWarning,
+				Summary:  "Stateful resource will be replaced",
+				Detail:   detail,
+			})
+		}
+	}
+
+	return diags, nil
+}
+
+// statefulResourceTypeNames returns the type names of all resources annotated as stateful.
+func statefulResourceTypeNames(ctx context.Context, servicePackages map[string]conns.ServicePackage) ([]string, error) {
+	var typeNames []string
+
+	for _, sp := range servicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.Stateful {
+				typeNames = append(typeNames, v.TypeName)
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			if !v.Stateful {
+				continue
+			}
+
+			r, err := v.Factory(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("creating resource: %w", err)
+			}
+
+			response := resource.MetadataResponse{}
+			r.Metadata(ctx, resource.MetadataRequest{}, &response)
+			typeNames = append(typeNames, response.TypeName)
+		}
+	}
+
+	return typeNames, nil
+}
+
+func dynamicValueIsNull(v *tfprotov5.DynamicValue) (bool, error) {
+	if v == nil {
+		return true, nil
+	}
+
+	return v.IsNull()
+}
+
+// attributePathStrings returns human-readable representations of the specified attribute paths,
+// for example `ebs_block_device[0].volume_size`.
+func attributePathStrings(paths []*tftypes.AttributePath) []string {
+	var ss []string
+
+	for _, path := range paths {
+		var sb strings.Builder
+
+		for _, step := range path.Steps() {
+			switch step := step.(type) {
+			case tftypes.AttributeName:
+				if sb.Len() > 0 {
+					sb.WriteString(".")
+				}
+				sb.WriteString(string(step))
+			case tftypes.ElementKeyInt:
+				fmt.Fprintf(&sb, "[%d]", int64(step))
+			case tftypes.ElementKeyString:
+				fmt.Fprintf(&sb, "[%q]", string(step))
+			case tftypes.ElementKeyValue:
+				sb.WriteString("[*]")
+			}
+		}
+
+		ss = append(ss, "`"+sb.String()+"`")
+	}
+
+	return ss
+}
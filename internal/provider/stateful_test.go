@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type mockPlanResourceChangeServer struct {
+	tfprotov5.ProviderServer
+
+	requiresReplace []*tftypes.AttributePath
+}
+
+func (s mockPlanResourceChangeServer) PlanResourceChange(context.Context, *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	return &tfprotov5.PlanResourceChangeResponse{
+		RequiresReplace: s.requiresReplace,
+	}, nil
+}
+
+func TestStatefulResourceServerPlanResourceChange(t *testing.T) {
+	t.Parallel()
+
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name": tftypes.String,
+	}}
+	dynamicValue := func(v any) *tfprotov5.DynamicValue {
+		t.Helper()
+
+		dv, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, v))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &dv
+	}
+	null := dynamicValue(nil)
+	value := dynamicValue(map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test"),
+	})
+	requiresReplace := []*tftypes.AttributePath{
+		tftypes.NewAttributePath().WithAttributeName("name"),
+		tftypes.NewAttributePath().WithAttributeName("block").WithElementKeyInt(0).WithAttributeName("size"),
+	}
+
+	testCases := map[string]struct {
+		typeName        string
+		priorState      *tfprotov5.DynamicValue
+		proposedState   *tfprotov5.DynamicValue
+		requiresReplace []*tftypes.AttributePath
+		protected       bool
+		wantSeverity    tfprotov5.DiagnosticSeverity
+		wantDetail      string
+	}{
+		"not stateful": {
+			typeName:        "aws_vpc",
+			priorState:      value,
+			proposedState:   value,
+			requiresReplace: requiresReplace,
+			protected:       true,
+		},
+		"create": {
+			typeName:      "aws_db_instance",
+			priorState:    null,
+			proposedState: value,
+			protected:     true,
+		},
+		"update": {
+			typeName:      "aws_db_instance",
+			priorState:    value,
+			proposedState: value,
+			protected:     true,
+		},
+		"replace": {
+			typeName:        "aws_db_instance",
+			priorState:      value,
+			proposedState:   value,
+			requiresReplace: requiresReplace,
+			wantSeverity:    tfprotov5.DiagnosticSeverityWarning,
+			wantDetail:      "force replacement of this aws_db_instance: `name`, `block[0].size`.",
+		},
+		"replace protected": {
+			typeName:        "aws_db_instance",
+			priorState:      value,
+			proposedState:   value,
+			requiresReplace: requiresReplace,
+			protected:       true,
+			wantSeverity:    tfprotov5.DiagnosticSeverityError,
+			wantDetail:      `add "aws_db_instance" to the provider's allowed_stateful_resource_changes`,
+		},
+		"destroy": {
+			typeName:      "aws_db_instance",
+			priorState:    value,
+			proposedState: null,
+		},
+		"destroy protected": {
+			typeName:      "aws_db_instance",
+			priorState:    value,
+			proposedState: null,
+			protected:     true,
+			wantSeverity:  tfprotov5.DiagnosticSeverityError,
+			wantDetail:    "Deleting this aws_db_instance also deletes any data it holds.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			server := newStatefulResourceServer(
+				mockPlanResourceChangeServer{requiresReplace: testCase.requiresReplace},
+				[]string{"aws_db_instance"},
+				func(context.Context, string) bool { return testCase.protected },
+			)
+
+			response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         testCase.typeName,
+				PriorState:       testCase.priorState,
+				ProposedNewState: testCase.proposedState,
+			})
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if testCase.wantSeverity == tfprotov5.DiagnosticSeverityInvalid {
+				if len(response.Diagnostics) > 0 {
+					t.Errorf("unexpected diagnostics: %v", response.Diagnostics[0])
+				}
+
+				return
+			}
+
+			if got, want := len(response.Diagnostics), 1; got != want {
+				t.Fatalf("got %d diagnostics, want %d", got, want)
+			}
+
+			diag := response.Diagnostics[0]
+
+			if got, want := diag.Severity, testCase.wantSeverity; got != want {
+				t.Errorf("Severity = %v, want %v", got, want)
+			}
+
+			if !strings.Contains(diag.Detail, testCase.wantDetail) {
+				t.Errorf("Detail = %q, want to contain %q", diag.Detail, testCase.wantDetail)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// tagPolicyResourceServer wraps a protocol version 5 provider server, warning of planned resource tags that don't comply
+// with a tag policy configured in warn mode.
+// Plugin SDK CustomizeDiff functions cannot return warnings so the check is made on the PlanResourceChange response.
+// Framework resources warn from their ModifyPlan interceptor and tag policy violations in error mode are reported
+// from the Plugin SDK resource's CustomizeDiff.
+type tagPolicyResourceServer struct {
+	tfprotov5.ProviderServer
+
+	// policy returns the configured provider's tag policy.
+	policy    func(context.Context) *tftags.PolicyConfig
+	typeNames map[string]struct{}
+
+	mu         sync.Mutex
+	valueTypes map[string]tftypes.Type
+}
+
+func newTagPolicyResourceServer(server tfprotov5.ProviderServer, typeNames []string, policy func(context.Context) *tftags.PolicyConfig) *tagPolicyResourceServer {
+	s := &tagPolicyResourceServer{
+		ProviderServer: server,
+		policy:         policy,
+		typeNames:      make(map[string]struct{}, len(typeNames)),
+	}
+
+	for _, typeName := range typeNames {
+		s.typeNames[typeName] = struct{}{}
+	}
+
+	return s
+}
+
+func (s *tagPolicyResourceServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	if _, ok := s.typeNames[request.TypeName]; !ok {
+		return response, nil
+	}
+
+	for _, v := range response.Diagnostics {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			return response, nil
+		}
+	}
+
+	policy := s.policy(ctx)
+	if !policy.IsWarn() {
+		return response, nil
+	}
+
+	diags, err := s.validatePlan(ctx, request, response, policy)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response.Diagnostics = append(response.Diagnostics, diags...)
+
+	return response, nil
+}
+
+// validatePlan returns diagnostics for the planned tags of a resource that is being created, or whose tags are changing.
+func (s *tagPolicyResourceServer) validatePlan(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest, response *tfprotov5.PlanResourceChangeResponse, policy *tftags.PolicyConfig) ([]*tfprotov5.Diagnostic, error) {
+	var diags []*tfprotov5.Diagnostic
+
+	// Nothing is tagged when a resource is deleted.
+	if isNull, err := dynamicValueIsNull(response.PlannedState); err != nil {
+		return nil, fmt.Errorf("determining if %s is being deleted: %w", request.TypeName, err)
+	} else if isNull {
+		return diags, nil
+	}
+
+	typ, err := s.valueType(ctx, request.TypeName)
+	if err != nil {
+		return nil, err
+	}
+
+	planned, err := tagsAllValue(typ, response.PlannedState)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s planned tags: %w", request.TypeName, err)
+	}
+
+	if !planned.IsFullyKnown() {
+		return diags, nil
+	}
+
+	if isNull, err := dynamicValueIsNull(request.PriorState); err != nil {
+		return nil, fmt.Errorf("determining if %s is being created: %w", request.TypeName, err)
+	} else if !isNull {
+		prior, err := tagsAllValue(typ, request.PriorState)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s prior tags: %w", request.TypeName, err)
+		}
+
+		if planned.Equal(prior) {
+			return diags, nil
+		}
+	}
+
+	var elements map[string]tftypes.Value
+	if !planned.IsNull() {
+		if err := planned.As(&elements); err != nil {
+			return nil, fmt.Errorf("reading %s planned tags: %w", request.TypeName, err)
+		}
+	}
+
+	tags := make(map[string]string, len(elements))
+	for k, v := range elements {
+		var tag string
+		if err := v.As(&tag); err != nil {
+			return nil, fmt.Errorf("reading %s planned tag (%s): %w", request.TypeName, k, err)
+		}
+		tags[k] = tag
+	}
+
+	if err := policy.Validate(tftags.New(ctx, tags)); err != nil {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityWarning,
+			Summary:   "Resource tags don't comply with tag policy",
+			Detail:    err.Error(),
+			Attribute: tftypes.NewAttributePath().WithAttributeName(names.AttrTags),
+		})
+	}
+
+	return diags, nil
+}
+
+// valueType returns the type of the specified resource's state, from the wrapped server's schema.
+func (s *tagPolicyResourceServer) valueType(ctx context.Context, typeName string) (tftypes.Type, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.valueTypes == nil {
+		response, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("reading provider schema: %w", err)
+		}
+
+		s.valueTypes = make(map[string]tftypes.Type, len(s.typeNames))
+		for typeName := range s.typeNames {
+			if v, ok := response.ResourceSchemas[typeName]; ok {
+				s.valueTypes[typeName] = v.ValueType()
+			}
+		}
+	}
+
+	typ, ok := s.valueTypes[typeName]
+	if !ok {
+		return nil, fmt.Errorf("no schema for %s", typeName)
+	}
+
+	return typ, nil
+}
+
+// tagsAllValue returns the value of the `tags_all` attribute of the specified resource state.
+func tagsAllValue(typ tftypes.Type, v *tfprotov5.DynamicValue) (tftypes.Value, error) {
+	value, err := v.Unmarshal(typ)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := value.As(&attributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	tagsAll, ok := attributes[names.AttrTagsAll]
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("no %s attribute", names.AttrTagsAll)
+	}
+
+	return tagsAll, nil
+}
+
+// tagPolicyResourceTypeNames returns the type names of all Plugin SDK resources with transparent tagging.
+func tagPolicyResourceTypeNames(ctx context.Context, servicePackages map[string]conns.ServicePackage) []string {
+	var typeNames []string
+
+	for _, sp := range servicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.Tags != nil {
+				typeNames = append(typeNames, v.TypeName)
+			}
+		}
+	}
+
+	return typeNames
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+type mockTagPolicyServer struct {
+	tfprotov5.ProviderServer
+
+	plannedState *tfprotov5.DynamicValue
+}
+
+func (s mockTagPolicyServer) GetProviderSchema(context.Context, *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return &tfprotov5.GetProviderSchemaResponse{
+		ResourceSchemas: map[string]*tfprotov5.Schema{
+			"aws_vpc": {
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{Name: "tags", Type: tftypes.Map{ElementType: tftypes.String}, Optional: true},
+						{Name: "tags_all", Type: tftypes.Map{ElementType: tftypes.String}, Optional: true, Computed: true},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (s mockTagPolicyServer) PlanResourceChange(context.Context, *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	return &tfprotov5.PlanResourceChangeResponse{
+		PlannedState: s.plannedState,
+	}, nil
+}
+
+func TestTagPolicyResourceServerPlanResourceChange(t *testing.T) {
+	t.Parallel()
+
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"tags":     mapType,
+		"tags_all": mapType,
+	}}
+	dynamicValue := func(tagsAll tftypes.Value) *tfprotov5.DynamicValue {
+		t.Helper()
+
+		var v any
+		if tagsAll.Type() != nil {
+			v = map[string]tftypes.Value{
+				"tags":     tftypes.NewValue(mapType, nil),
+				"tags_all": tagsAll,
+			}
+		}
+
+		dv, err := tfprotov5.NewDynamicValue(typ, tftypes.NewValue(typ, v))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &dv
+	}
+	tags := func(kv ...string) tftypes.Value {
+		v := make(map[string]tftypes.Value)
+		for i := 0; i < len(kv); i += 2 {
+			v[kv[i]] = tftypes.NewValue(tftypes.String, kv[i+1])
+		}
+
+		return tftypes.NewValue(mapType, v)
+	}
+	null := dynamicValue(tftypes.Value{})
+	compliant := dynamicValue(tags("Owner", "team-a"))
+	nonCompliant := dynamicValue(tags("Name", "test"))
+	unknown := dynamicValue(tftypes.NewValue(mapType, tftypes.UnknownValue))
+
+	testCases := map[string]struct {
+		typeName     string
+		mode         string
+		priorState   *tfprotov5.DynamicValue
+		plannedState *tfprotov5.DynamicValue
+		wantWarning  bool
+	}{
+		"no tags": {
+			typeName:     "aws_instance",
+			mode:         tftags.PolicyModeWarn,
+			priorState:   null,
+			plannedState: nonCompliant,
+		},
+		"error mode": {
+			typeName:     "aws_vpc",
+			mode:         tftags.PolicyModeError,
+			priorState:   null,
+			plannedState: nonCompliant,
+		},
+		"create compliant": {
+			typeName:     "aws_vpc",
+			mode:         tftags.PolicyModeWarn,
+			priorState:   null,
+			plannedState: compliant,
+		},
+		"create non-compliant": {
+			typeName:     "aws_vpc",
+			mode:         tftags.PolicyModeWarn,
+			priorState:   null,
+			plannedState: nonCompliant,
+			wantWarning:  true,
+		},
+		"create unknown": {
+			typeName:     "aws_vpc",
+			mode:         tftags.PolicyModeWarn,
+			priorState:   null,
+			plannedState: unknown,
+		},
+		"update non-compliant": {
+			typeName:     "aws_vpc",
+			mode:         tftags.PolicyModeWarn,
+			priorState:   compliant,
+			plannedState: nonCompliant,
+			wantWarning:  true,
+		},
+		"update unchanged": {
+			typeName:     "aws_vpc",
+			mode:         tftags.PolicyModeWarn,
+			priorState:   nonCompliant,
+			plannedState: nonCompliant,
+		},
+		"destroy": {
+			typeName:     "aws_vpc",
+			mode:         tftags.PolicyModeWarn,
+			priorState:   nonCompliant,
+			plannedState: null,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			server := newTagPolicyResourceServer(
+				mockTagPolicyServer{plannedState: testCase.plannedState},
+				[]string{"aws_vpc"},
+				func(context.Context) *tftags.PolicyConfig {
+					return &tftags.PolicyConfig{
+						Mode:         testCase.mode,
+						RequiredKeys: []string{"Owner"},
+					}
+				},
+			)
+
+			response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:   testCase.typeName,
+				PriorState: testCase.priorState,
+			})
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !testCase.wantWarning {
+				if len(response.Diagnostics) > 0 {
+					t.Errorf("unexpected diagnostics: %v", response.Diagnostics[0])
+				}
+
+				return
+			}
+
+			if got, want := len(response.Diagnostics), 1; got != want {
+				t.Fatalf("got %d diagnostics, want %d", got, want)
+			}
+
+			diag := response.Diagnostics[0]
+
+			if got, want := diag.Severity, tfprotov5.DiagnosticSeverityWarning; got != want {
+				t.Errorf("Severity = %v, want %v", got, want)
+			}
+
+			if got, want := diag.Attribute, tftypes.NewAttributePath().WithAttributeName("tags"); !got.Equal(want) {
+				t.Errorf("Attribute = %v, want %v", got, want)
+			}
+
+			if want := "Owner"; !strings.Contains(diag.Detail, want) {
+				t.Errorf("Detail = %q, want to contain %q", diag.Detail, want)
+			}
+		})
+	}
+}
@@ -27,6 +27,11 @@ import (
 )
 
 // @SDKResource("aws_accessanalyzer_archive_rule")
+// @IDHelpers(parse="archiveRuleParseResourceID", create="archiveRuleCreateResourceID")
+// @IDExample("my-analyzer/my-rule")
+// @IDExample("my-analyzer", valid=false)
+// @IDExample("my-analyzer/", valid=false)
+// @IDExample("/my-rule", valid=false)
 func resourceArchiveRule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceArchiveRuleCreate,
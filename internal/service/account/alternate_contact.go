@@ -29,6 +29,10 @@ import (
 )
 
 // @SDKResource("aws_account_alternate_contact")
+// @Region(global=true)
+// @IDHelpers(parse="alternateContactParseResourceID", create="alternateContactCreateResourceID")
+// @IDExample("123456789012/BILLING")
+// @IDExample("123456789012/BILLING/OPERATIONS", valid=false)
 func resourceAlternateContact() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAlternateContactCreate,
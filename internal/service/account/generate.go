@@ -2,6 +2,7 @@
 // SPDX-License-Identifier: MPL-2.0
 
 //go:generate go run ../../generate/servicepackage/main.go
+//go:generate go run ../../generate/idtests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package account
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_account_region", name="Region")
+// @Region(global=true)
 func resourceRegion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRegionUpdate,
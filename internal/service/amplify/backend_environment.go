@@ -25,6 +25,10 @@ import (
 )
 
 // @SDKResource("aws_amplify_backend_environment", name="Backend Environment")
+// @IDHelpers(parse="backendEnvironmentParseResourceID", create="backendEnvironmentCreateResourceID")
+// @IDExample("d2ybwe2jvy6c7k/example")
+// @IDExample("d2ybwe2jvy6c7k", valid=false)
+// @IDExample("d2ybwe2jvy6c7k/", valid=false)
 func resourceBackendEnvironment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBackendEnvironmentCreate,
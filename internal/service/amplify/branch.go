@@ -30,6 +30,11 @@ import (
 
 // @SDKResource("aws_amplify_branch", name="Branch")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="branchParseResourceID", create="branchCreateResourceID")
+// @IDExample("d2ybwe2jvy6c7k/main")
+// @IDExample("d2ybwe2jvy6c7k/feature/my-branch")
+// @IDExample("d2ybwe2jvy6c7k", valid=false)
+// @IDExample("d2ybwe2jvy6c7k/", valid=false)
 func resourceBranch() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBranchCreate,
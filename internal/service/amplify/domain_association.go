@@ -27,6 +27,11 @@ import (
 )
 
 // @SDKResource("aws_amplify_domain_association", name="Domain Association")
+// @IDHelpers(parse="domainAssociationParseResourceID", create="domainAssociationCreateResourceID")
+// @IDExample("d2ybwe2jvy6c7k/example.com")
+// @IDExample("d2ybwe2jvy6c7k", valid=false)
+// @IDExample("/example.com", valid=false)
+// @IDExample("d2ybwe2jvy6c7k/example.com/www", valid=false)
 func resourceDomainAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainAssociationCreate,
@@ -26,6 +26,11 @@ import (
 const emptyBasePathMappingValue = "(none)"
 
 // @SDKResource("aws_api_gateway_base_path_mapping", name="Base Path Mapping")
+// @IDHelpers(parse="basePathMappingParseResourceID", create="basePathMappingCreateResourceID")
+// @IDExample("api.example.com/v1")
+// @IDExample("api.example.com/v1/users")
+// @IDExample("api.example.com", valid=false)
+// @IDExample("/v1", valid=false)
 func resourceBasePathMapping() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBasePathMappingCreate,
@@ -23,6 +23,10 @@ import (
 )
 
 // @SDKResource("aws_api_gateway_documentation_part", name="Documentation Part")
+// @IDHelpers(parse="documentationPartParseResourceID", create="documentationPartCreateResourceID")
+// @IDExample("a1b2c3d4e5/abc123")
+// @IDExample("a1b2c3d4e5", valid=false)
+// @IDExample("a1b2c3d4e5/abc123/extra", valid=false)
 func resourceDocumentationPart() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDocumentationPartCreate,
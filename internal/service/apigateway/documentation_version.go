@@ -23,6 +23,10 @@ import (
 )
 
 // @SDKResource("aws_api_gateway_documentation_version", name="Documentation Version")
+// @IDHelpers(parse="documentationVersionParseResourceID", create="documentationVersionCreateResourceID")
+// @IDExample("a1b2c3d4e5/1.0")
+// @IDExample("a1b2c3d4e5", valid=false)
+// @IDExample("a1b2c3d4e5/1.0/extra", valid=false)
 func resourceDocumentationVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDocumentationVersionCreate,
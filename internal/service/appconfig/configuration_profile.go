@@ -28,6 +28,10 @@ import (
 
 // @SDKResource("aws_appconfig_configuration_profile", name="Connection Profile")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ConfigurationProfileParseID")
+// @IDExample("abc1234:def5678")
+// @IDExample("abc1234", valid=false)
+// @IDExample("abc1234:def5678:extra", valid=false)
 func ResourceConfigurationProfile() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceConfigurationProfileCreate,
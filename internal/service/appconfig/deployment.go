@@ -30,6 +30,10 @@ import (
 
 // @SDKResource("aws_appconfig_deployment", name="Deployment")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="DeploymentParseID")
+// @IDExample("abc1234/def5678/1")
+// @IDExample("abc1234/def5678", valid=false)
+// @IDExample("abc1234/def5678/one", valid=false)
 func ResourceDeployment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDeploymentCreate,
@@ -25,6 +25,10 @@ import (
 )
 
 // @SDKResource("aws_appconfig_hosted_configuration_version")
+// @IDHelpers(parse="HostedConfigurationVersionParseID")
+// @IDExample("abc1234/def5678/1")
+// @IDExample("abc1234/def5678", valid=false)
+// @IDExample("abc1234/def5678/one", valid=false)
 func ResourceHostedConfigurationVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHostedConfigurationVersionCreate,
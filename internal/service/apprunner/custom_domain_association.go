@@ -27,6 +27,10 @@ import (
 )
 
 // @SDKResource("aws_apprunner_custom_domain_association", name="Custom Domain Association")
+// @IDHelpers(parse="customDomainAssociationParseResourceID", create="customDomainAssociationCreateResourceID")
+// @IDExample("example.com,arn:aws:apprunner:us-east-1:123456789012:service/my-service/8fe1e10304f84fd2b0df550fe98a71fa")
+// @IDExample("example.com", valid=false)
+// @IDExample("example.com,", valid=false)
 func resourceCustomDomainAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCustomDomainAssociationCreate,
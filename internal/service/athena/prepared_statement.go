@@ -26,6 +26,10 @@ import (
 )
 
 // @SDKResource("aws_athena_prepared_statement", name="Prepared Statement")
+// @IDHelpers(parse="preparedStatementParseResourceID", create="preparedStatementCreateResourceID")
+// @IDExample("primary/my-statement")
+// @IDExample("primary", valid=false)
+// @IDExample("primary/my-statement/extra", valid=false)
 func resourcePreparedStatement() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePreparedStatementCreate,
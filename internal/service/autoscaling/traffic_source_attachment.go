@@ -26,6 +26,10 @@ import (
 )
 
 // @SDKResource("aws_autoscaling_traffic_source_attachment", name="Traffic Source Attachment")
+// @IDHelpers(parse="trafficSourceAttachmentParseResourceID", create="trafficSourceAttachmentCreateResourceID")
+// @IDExample("my-asg,vpc-lattice,tg-0123456789abcdef0")
+// @IDExample("my-asg,vpc-lattice", valid=false)
+// @IDExample("my-asg,,tg-0123456789abcdef0", valid=false)
 func resourceTrafficSourceAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTrafficSourceAttachmentCreate,
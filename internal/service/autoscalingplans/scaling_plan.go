@@ -25,6 +25,10 @@ import (
 )
 
 // @SDKResource("aws_autoscalingplans_scaling_plan")
+// @IDHelpers(parse="scalingPlanParseResourceID", create="scalingPlanCreateResourceID")
+// @IDExample("my-plan/1")
+// @IDExample("my-plan/one", valid=false)
+// @IDExample("my-plan", valid=false)
 func ResourceScalingPlan() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceScalingPlanCreate,
@@ -33,6 +33,7 @@ import (
 )
 
 // @FrameworkResource("aws_bcmdataexports_export",name="Export")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func newResourceExport(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceExport{}
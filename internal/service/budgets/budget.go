@@ -34,7 +34,12 @@ import (
 )
 
 // @SDKResource("aws_budgets_budget")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="BudgetParseResourceID", create="BudgetCreateResourceID")
+// @IDExample("123456789012:my-budget")
+// @IDExample("my-budget", valid=false)
+// @IDExample("123456789012:", valid=false)
 func ResourceBudget() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBudgetCreate,
@@ -31,7 +31,12 @@ import (
 )
 
 // @SDKResource("aws_budgets_budget_action")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="BudgetActionParseResourceID", create="BudgetActionCreateResourceID")
+// @IDExample("123456789012:00000000-0000-0000-0000-000000000000:my-budget")
+// @IDExample("123456789012:my-budget", valid=false)
+// @IDExample("123456789012::my-budget", valid=false)
 func ResourceBudgetAction() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBudgetActionCreate,
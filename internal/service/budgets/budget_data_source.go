@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKDataSource("aws_budgets_budget")
+// @Region(global=true)
 func DataSourceBudget() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceBudgetRead,
@@ -28,6 +28,7 @@ import (
 )
 
 // @SDKResource("aws_ce_anomaly_monitor", name="Anomaly Monitor")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func resourceAnomalyMonitor() *schema.Resource {
 	return &schema.Resource{
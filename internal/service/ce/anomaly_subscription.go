@@ -31,6 +31,7 @@ const (
 )
 
 // @SDKResource("aws_ce_anomaly_subscription", name="Anomaly Subscription")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func resourceAnomalySubscription() *schema.Resource {
 	return &schema.Resource{
@@ -31,6 +31,7 @@ const (
 )
 
 // @SDKResource("aws_ce_cost_category", name="Cost Category")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func resourceCostCategory() *schema.Resource {
 	return &schema.Resource{
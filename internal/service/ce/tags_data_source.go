@@ -23,6 +23,7 @@ const (
 )
 
 // @SDKDataSource("aws_ce_tags", name="Tags")
+// @Region(global=true)
 func dataSourceTags() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceTagsRead,
@@ -25,6 +25,9 @@ import (
 )
 
 // @SDKResource("aws_cloud9_environment_membership", name="Environment Membership")
+// @IDHelpers(parse="environmentMembershipParseResourceID", create="environmentMembershipCreateResourceID")
+// @IDExample("1a2b3c4d5e6f7890abcdef1234567890#arn:aws:iam::123456789012:user/my-user")
+// @IDExample("1a2b3c4d5e6f7890abcdef1234567890", valid=false)
 func resourceEnvironmentMembership() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEnvironmentMembershipCreate,
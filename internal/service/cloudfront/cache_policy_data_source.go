@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_cache_policy", name="Cache Policy")
+// @Region(global=true)
 func dataSourceCachePolicy() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceCachePolicyRead,
@@ -27,6 +27,7 @@ import (
 )
 
 // @FrameworkResource(name="Continuous Deployment Policy")
+// @Region(global=true)
 func newContinuousDeploymentPolicyResource(context.Context) (resource.ResourceWithConfigure, error) {
 	return &continuousDeploymentPolicyResource{}, nil
 }
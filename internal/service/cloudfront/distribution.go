@@ -29,6 +29,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_distribution", name="Distribution")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceDistribution() *schema.Resource {
 	//lintignore:R011
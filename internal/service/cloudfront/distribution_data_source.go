@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_distribution", name="Distribution")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func dataSourceDistribution() *schema.Resource {
 	return &schema.Resource{
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_field_level_encryption_config", name="Field-level Encryption Config")
+// @Region(global=true)
 func resourceFieldLevelEncryptionConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFieldLevelEncryptionConfigCreate,
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_function", name="Function")
+// @Region(global=true)
 func dataSourceFunction() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceFunctionRead,
@@ -32,6 +32,7 @@ import (
 )
 
 // @FrameworkResource(name="Key Value Store")
+// @Region(global=true)
 func newKeyValueStoreResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &keyValueStoreResource{}
 
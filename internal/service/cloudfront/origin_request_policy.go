@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_origin_request_policy", name="Origin Request Policy")
+// @Region(global=true)
 func resourceOriginRequestPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOriginRequestPolicyCreate,
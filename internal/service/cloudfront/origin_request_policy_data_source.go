@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_origin_request_policy", name="Origin Request Policy")
+// @Region(global=true)
 func dataSourceOriginRequestPolicy() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOriginRequestPolicyRead,
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_cloudfront_realtime_log_config", name="Real-time Log Config")
+// @Region(global=true)
 func resourceRealtimeLogConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRealtimeLogConfigCreate,
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_realtime_log_config", name="Real-time Log Config")
+// @Region(global=true)
 func dataSourceRealtimeLogConfig() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceRealtimeLogConfigRead,
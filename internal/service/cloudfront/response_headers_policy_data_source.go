@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_cloudfront_response_headers_policy", name="Response Headers Policy")
+// @Region(global=true)
 func dataSourceResponseHeadersPolicy() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceResponseHeadersPolicyRead,
@@ -26,6 +26,10 @@ import (
 )
 
 // @SDKResource("aws_codecommit_approval_rule_template_association", name="Approval Rule Template Association")
+// @IDHelpers(parse="approvalRuleTemplateAssociationParseResourceID", create="approvalRuleTemplateAssociationCreateResourceID")
+// @IDExample("my-template,my-repository")
+// @IDExample("my-template", valid=false)
+// @IDExample(",my-repository", valid=false)
 func resourceApprovalRuleTemplateAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceApprovalRuleTemplateAssociationCreate,
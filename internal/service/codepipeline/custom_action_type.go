@@ -29,6 +29,10 @@ import (
 
 // @SDKResource("aws_codepipeline_custom_action_type", name="Custom Action Type")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="CustomActionTypeParseResourceID")
+// @IDExample("Build/MyProvider/1")
+// @IDExample("Build/MyProvider", valid=false)
+// @IDExample("Build//1", valid=false)
 func resourceCustomActionType() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCustomActionTypeCreate,
@@ -25,6 +25,10 @@ import (
 )
 
 // @SDKResource("aws_cognito_identity_provider", name="Identity Provider")
+// @IDHelpers(parse="identityProviderParseResourceID", create="identityProviderCreateResourceID")
+// @IDExample("us-west-2_abc123:Google")
+// @IDExample("us-west-2_abc123", valid=false)
+// @IDExample("us-west-2_abc123:Google:extra", valid=false)
 func resourceIdentityProvider() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceIdentityProviderCreate,
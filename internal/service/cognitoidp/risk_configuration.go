@@ -24,6 +24,10 @@ import (
 )
 
 // @SDKResource("aws_cognito_risk_configuration", name="Risk Configuration")
+// @IDHelpers(parse="RiskConfigurationParseID")
+// @IDExample("us-west-2_abc123")
+// @IDExample("us-west-2_abc123:1example23456789")
+// @IDExample("us-west-2_abc123:1example23456789:extra", valid=false)
 func resourceRiskConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRiskConfigurationPut,
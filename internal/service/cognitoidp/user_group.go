@@ -25,6 +25,10 @@ import (
 )
 
 // @SDKResource("aws_cognito_user_group", name="User Group")
+// @IDHelpers(parse="userGroupParseResourceID", create="userGroupCreateResourceID")
+// @IDExample("us-west-2_abc123/my-group")
+// @IDExample("us-west-2_abc123", valid=false)
+// @IDExample("/my-group", valid=false)
 func resourceUserGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserGroupCreate,
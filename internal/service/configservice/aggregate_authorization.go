@@ -25,6 +25,10 @@ import (
 
 // @SDKResource("aws_config_aggregate_authorization", name="Aggregate Authorization")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="aggregateAuthorizationParseResourceID", create="aggregateAuthorizationCreateResourceID")
+// @IDExample("123456789012:us-west-2")
+// @IDExample("123456789012", valid=false)
+// @IDExample("123456789012:us-west-2:extra", valid=false)
 func resourceAggregateAuthorization() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAggregateAuthorizationCreate,
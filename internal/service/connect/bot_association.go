@@ -20,6 +20,9 @@ import (
 )
 
 // @SDKResource("aws_connect_bot_association")
+// @IDHelpers(parse="BotV1AssociationParseResourceID", create="BotV1AssociationCreateResourceID")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111:MyBot:us-west-2")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111:MyBot", valid=false)
 func ResourceBotAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBotAssociationCreate,
@@ -29,6 +29,10 @@ const contactFlowMutexKey = `aws_connect_contact_flow`
 
 // @SDKResource("aws_connect_contact_flow", name="Contact Flow")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ContactFlowParseID")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111", valid=false)
+// @IDExample(":12345678-1234-1234-1234-123456789012", valid=false)
 func ResourceContactFlow() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceContactFlowCreate,
@@ -29,6 +29,10 @@ const contactFlowModuleMutexKey = `aws_connect_contact_flow_module`
 
 // @SDKResource("aws_connect_contact_flow_module", name="Contact Flow Module")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ContactFlowModuleParseID")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111", valid=false)
+// @IDExample(":12345678-1234-1234-1234-123456789012", valid=false)
 func ResourceContactFlowModule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceContactFlowModuleCreate,
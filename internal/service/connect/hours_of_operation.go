@@ -26,6 +26,10 @@ import (
 
 // @SDKResource("aws_connect_hours_of_operation", name="Hours Of Operation")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="HoursOfOperationParseID")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111", valid=false)
+// @IDExample(":12345678-1234-1234-1234-123456789012", valid=false)
 func ResourceHoursOfOperation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHoursOfOperationCreate,
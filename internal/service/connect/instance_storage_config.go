@@ -22,6 +22,9 @@ import (
 )
 
 // @SDKResource("aws_connect_instance_storage_config")
+// @IDHelpers(parse="InstanceStorageConfigParseId")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef:CHAT_TRANSCRIPTS")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", valid=false)
 func ResourceInstanceStorageConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInstanceStorageConfigCreate,
@@ -20,6 +20,9 @@ import (
 )
 
 // @SDKResource("aws_connect_lambda_function_association")
+// @IDHelpers(parse="LambdaFunctionAssociationParseResourceID", create="LambdaFunctionAssociationCreateResourceID")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111,arn:aws:lambda:us-west-2:123456789012:function:my-function")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111", valid=false)
 func ResourceLambdaFunctionAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLambdaFunctionAssociationCreate,
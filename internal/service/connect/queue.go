@@ -25,6 +25,10 @@ import (
 
 // @SDKResource("aws_connect_queue", name="Queue")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="QueueParseID")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111", valid=false)
+// @IDExample(":12345678-1234-1234-1234-123456789012", valid=false)
 func ResourceQueue() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceQueueCreate,
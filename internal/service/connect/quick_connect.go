@@ -24,6 +24,10 @@ import (
 
 // @SDKResource("aws_connect_quick_connect", name="Quick Connect")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="QuickConnectParseID")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012")
+// @IDExample("aaaaaaaa-bbbb-cccc-dddd-111111111111", valid=false)
+// @IDExample(":12345678-1234-1234-1234-123456789012", valid=false)
 func ResourceQuickConnect() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceQuickConnectCreate,
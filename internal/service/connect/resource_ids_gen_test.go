@@ -0,0 +1,432 @@
+// Code generated by internal/generate/idtests/main.go; DO NOT EDIT.
+
+package connect
+
+import (
+	"testing"
+)
+
+func TestBotAssociationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:MyBot:us-west-2": true, //lintignore:AWSAT003
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:MyBot":           false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := BotV1AssociationParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("BotV1AssociationParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("BotV1AssociationParseResourceID(%q): %s", id, err)
+			}
+
+			got := BotV1AssociationCreateResourceID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("BotV1AssociationCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestContactFlowResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012": true,
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111":                                      false,
+		":12345678-1234-1234-1234-123456789012":                                     false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := ContactFlowParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ContactFlowParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ContactFlowParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestContactFlowModuleResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012": true,
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111":                                      false,
+		":12345678-1234-1234-1234-123456789012":                                     false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := ContactFlowModuleParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ContactFlowModuleParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ContactFlowModuleParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestHoursOfOperationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012": true,
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111":                                      false,
+		":12345678-1234-1234-1234-123456789012":                                     false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := HoursOfOperationParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("HoursOfOperationParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("HoursOfOperationParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestInstanceStorageConfigResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef:CHAT_TRANSCRIPTS": true,
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef":                  false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, _, err := InstanceStorageConfigParseId(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("InstanceStorageConfigParseId(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("InstanceStorageConfigParseId(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestLambdaFunctionAssociationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111,arn:aws:lambda:us-west-2:123456789012:function:my-function": true, //lintignore:AWSAT003,AWSAT005
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := LambdaFunctionAssociationParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("LambdaFunctionAssociationParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("LambdaFunctionAssociationParseResourceID(%q): %s", id, err)
+			}
+
+			got := LambdaFunctionAssociationCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("LambdaFunctionAssociationCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestQueueResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012": true,
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111":                                      false,
+		":12345678-1234-1234-1234-123456789012":                                     false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := QueueParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("QueueParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("QueueParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestQuickConnectResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012": true,
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111":                                      false,
+		":12345678-1234-1234-1234-123456789012":                                     false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := QuickConnectParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("QuickConnectParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("QuickConnectParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestRoutingProfileResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012": true,
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111":                                      false,
+		":12345678-1234-1234-1234-123456789012":                                     false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := RoutingProfileParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("RoutingProfileParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("RoutingProfileParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestSecurityProfileResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012": true,
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111":                                      false,
+		":12345678-1234-1234-1234-123456789012":                                     false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := SecurityProfileParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("SecurityProfileParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("SecurityProfileParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestUserResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012": true,
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111":                                      false,
+		":12345678-1234-1234-1234-123456789012":                                     false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := UserParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("UserParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("UserParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestUserHierarchyGroupResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012": true,
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111":                                      false,
+		":12345678-1234-1234-1234-123456789012":                                     false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := UserHierarchyGroupParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("UserHierarchyGroupParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("UserHierarchyGroupParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestVocabularyResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111:12345678-1234-1234-1234-123456789012": true,
+		"aaaaaaaa-bbbb-cccc-dddd-111111111111":                                      false,
+		":12345678-1234-1234-1234-123456789012":                                     false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := VocabularyParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("VocabularyParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("VocabularyParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
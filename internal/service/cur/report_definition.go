@@ -28,6 +28,7 @@ import (
 )
 
 // @SDKResource("aws_cur_report_definition", name="Report Definition")
+// @Region(global=true)
 func resourceReportDefinition() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceReportDefinitionCreate,
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_cur_report_definition", name="Report Definition")
+// @Region(global=true)
 func dataSourceReportDefinition() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceReportDefinitionRead,
@@ -25,6 +25,9 @@ import (
 
 // @SDKResource("aws_dataexchange_revision", name="Revision")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="RevisionParseResourceID")
+// @IDExample("4fa784c7ccb4d1f1b4d0e5b3f2f5e4d1:5a0b8c1c4f3f2d1e0a9b8c7d6e5f4a3b")
+// @IDExample("4fa784c7ccb4d1f1b4d0e5b3f2f5e4d1", valid=false)
 func ResourceRevision() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRevisionCreate,
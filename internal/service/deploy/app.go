@@ -29,6 +29,8 @@ import (
 
 // @SDKResource("aws_codedeploy_app", name="App")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="appParseResourceID")
+// @IDExample("00000000-0000-0000-0000-000000000000:my-app")
 func resourceApp() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAppCreate,
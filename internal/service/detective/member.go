@@ -25,6 +25,10 @@ import (
 )
 
 // @SDKResource("aws_detective_member")
+// @IDHelpers(parse="MemberParseResourceID", create="memberCreateResourceID")
+// @IDExample("arn:aws:detective:us-west-2:123456789012:graph:b1e2a3c4d5e6f7a8b9c0d1e2f3a4b5c6/123456789012")
+// @IDExample("123456789012", valid=false)
+// @IDExample("arn:aws:detective:us-west-2:123456789012:graph:b1e2a3c4d5e6f7a8b9c0d1e2f3a4b5c6/", valid=false)
 func ResourceMember() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceMemberCreate,
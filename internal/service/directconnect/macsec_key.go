@@ -22,6 +22,9 @@ import (
 )
 
 // @SDKResource("aws_dx_macsec_key_association")
+// @IDHelpers(parse="MacSecKeyParseID")
+// @IDExample("arn:aws:secretsmanager:us-west-2:123456789012:secret:directconnect!prod/us-west-2/directconnect/0123456789abcdef-abcdef_dxcon-fg5678gh")
+// @IDExample("arn:aws:secretsmanager:us-west-2:123456789012:secret:directconnect!prod/us-west-2/directconnect/0123456789abcdef-abcdef", valid=false)
 func ResourceMacSecKeyAssociation() *schema.Resource {
 	return &schema.Resource{
 		// MacSecKey resource only supports create (Associate), read (Describe) and delete (Disassociate)
@@ -20,6 +20,9 @@ import (
 )
 
 // @SDKResource("aws_directory_service_conditional_forwarder")
+// @IDHelpers(parse="ParseConditionalForwarderID")
+// @IDExample("d-1234567890:example.com")
+// @IDExample("d-1234567890", valid=false)
 func ResourceConditionalForwarder() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceConditionalForwarderCreate,
@@ -26,6 +26,9 @@ import (
 
 // @SDKResource("aws_directory_service_region", name="Region")
 // @Tags
+// @IDHelpers(parse="RegionParseResourceID", create="RegionCreateResourceID")
+// @IDExample("d-1234567890,us-west-2")
+// @IDExample("d-1234567890", valid=false)
 func ResourceRegion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRegionCreate,
@@ -26,6 +26,10 @@ const (
 )
 
 // @SDKResource("aws_directory_service_shared_directory")
+// @IDHelpers(parse="parseSharedDirectoryID")
+// @IDExample("d-1234567890/d-0987654321")
+// @IDExample("d-1234567890", valid=false)
+// @IDExample("/d-0987654321", valid=false)
 func ResourceSharedDirectory() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSharedDirectoryCreate,
@@ -25,6 +25,11 @@ import (
 )
 
 // @SDKResource("aws_dynamodb_contributor_insights", name="Contributor Insights")
+// @IDHelpers(parse="contributorInsightsParseResourceID")
+// @IDExample("name:my-table/index:my-index/123456789012")
+// @IDExample("name:my-table/index:/123456789012")
+// @IDExample("name:my-table/index:my-index", valid=false)
+// @IDExample("/index:my-index/123456789012", valid=false)
 func resourceContributorInsights() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceContributorInsightsCreate,
@@ -19,28 +19,21 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-const (
-	kinesisStreamingDestinationResourceIDPartCount = 2
-)
-
 // @SDKResource("aws_dynamodb_kinesis_streaming_destination", name="Kinesis Streaming Destination")
+// @Identity(attributes="table_name;stream_arn")
 func resourceKinesisStreamingDestination() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKinesisStreamingDestinationCreate,
 		ReadWithoutTimeout:   resourceKinesisStreamingDestinationRead,
 		DeleteWithoutTimeout: resourceKinesisStreamingDestinationDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrStreamARN: {
 				Type:         schema.TypeString,
@@ -63,7 +56,7 @@ func resourceKinesisStreamingDestinationCreate(ctx context.Context, d *schema.Re
 
 	streamARN := d.Get(names.AttrStreamARN).(string)
 	tableName := d.Get(names.AttrTableName).(string)
-	id := errs.Must(flex.FlattenResourceId([]string{tableName, streamARN}, kinesisStreamingDestinationResourceIDPartCount, false))
+	id := errs.Must(identity.FormatID(resourceKinesisStreamingDestinationIdentity, tableName, streamARN))
 	input := &dynamodb.EnableKinesisStreamingDestinationInput{
 		StreamArn: aws.String(streamARN),
 		TableName: aws.String(tableName),
@@ -88,7 +81,7 @@ func resourceKinesisStreamingDestinationRead(ctx context.Context, d *schema.Reso
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
 
-	parts, err := flex.ExpandResourceId(d.Id(), kinesisStreamingDestinationResourceIDPartCount, false)
+	parts, err := identity.ParseID(resourceKinesisStreamingDestinationIdentity, d.Id())
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
@@ -116,7 +109,7 @@ func resourceKinesisStreamingDestinationDelete(ctx context.Context, d *schema.Re
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
 
-	parts, err := flex.ExpandResourceId(d.Id(), kinesisStreamingDestinationResourceIDPartCount, false)
+	parts, err := identity.ParseID(resourceKinesisStreamingDestinationIdentity, d.Id())
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
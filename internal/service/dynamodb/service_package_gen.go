@@ -12,6 +12,17 @@ import (
 
 type servicePackage struct{}
 
+var (
+	resourceKinesisStreamingDestinationIdentity = &types.ServicePackageResourceIdentity{
+		IDAttributes: []string{"table_name", "stream_arn"},
+		IDSeparator:  ",",
+	}
+	resourceTableIdentity = &types.ServicePackageResourceIdentity{
+		IDAttributes: []string{"name"},
+		ARNFormat:    "arn:{partition}:dynamodb:{region}:{account}:table/{name}",
+	}
+)
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{}
 }
@@ -56,6 +67,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceKinesisStreamingDestination,
 			TypeName: "aws_dynamodb_kinesis_streaming_destination",
 			Name:     "Kinesis Streaming Destination",
+			Identity: resourceKinesisStreamingDestinationIdentity,
 		},
 		{
 			Factory:  resourceTable,
@@ -64,6 +76,8 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: resourceTableIdentity,
+			Stateful: true,
 		},
 		{
 			Factory:  resourceTableExport,
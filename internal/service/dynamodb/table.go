@@ -45,7 +45,9 @@ const (
 )
 
 // @SDKResource("aws_dynamodb_table", name="Table")
+// @Stateful
 // @Tags(identifierAttribute="arn")
+// @Identity(attributes="name", arn="arn:{partition}:dynamodb:{region}:{account}:table/{name}")
 func resourceTable() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -37,6 +37,10 @@ const (
 
 // @SDKResource("aws_dynamodb_table_replica", name="Table Replica")
 // @Tags
+// @IDHelpers(parse="tableReplicaParseResourceID", create="tableReplicaCreateResourceID")
+// @IDExample("my-table:us-east-1")
+// @IDExample("my-table", valid=false)
+// @IDExample("my-table:us-east-1:extra", valid=false)
 func resourceTableReplica() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
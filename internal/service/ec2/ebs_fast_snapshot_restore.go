@@ -17,14 +17,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
-	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkResource(name="EBS Fast Snapshot Restore")
+// @Identity(attributes="availability_zone;snapshot_id")
 func newEBSFastSnapshotRestoreResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &ebsFastSnapshotRestoreResource{}
 
@@ -37,7 +38,6 @@ func newEBSFastSnapshotRestoreResource(_ context.Context) (resource.ResourceWith
 type ebsFastSnapshotRestoreResource struct {
 	framework.ResourceWithConfigure
 	framework.WithNoUpdate
-	framework.WithImportByID
 	framework.WithTimeouts
 }
 
@@ -190,13 +190,9 @@ type ebsFastSnapshotRestoreResourceModel struct {
 	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
-const (
-	ebsFastSnapshotRestoreIDPartCount = 2
-)
-
 func (data *ebsFastSnapshotRestoreResourceModel) InitFromID() error {
 	id := data.ID.ValueString()
-	parts, err := flex.ExpandResourceId(id, ebsFastSnapshotRestoreIDPartCount, false)
+	parts, err := identity.ParseID(newEBSFastSnapshotRestoreResourceIdentity, id)
 
 	if err != nil {
 		return err
@@ -209,5 +205,5 @@ func (data *ebsFastSnapshotRestoreResourceModel) InitFromID() error {
 }
 
 func (data *ebsFastSnapshotRestoreResourceModel) setID() {
-	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.AvailabilityZone.ValueString(), data.SnapshotID.ValueString()}, ebsFastSnapshotRestoreIDPartCount, false)))
+	data.ID = types.StringValue(errs.Must(identity.FormatID(newEBSFastSnapshotRestoreResourceIdentity, data.AvailabilityZone.ValueString(), data.SnapshotID.ValueString())))
 }
@@ -23,6 +23,10 @@ import (
 )
 
 // @SDKResource("aws_snapshot_create_volume_permission")
+// @IDHelpers(parse="EBSSnapshotCreateVolumePermissionParseResourceID", create="EBSSnapshotCreateVolumePermissionCreateResourceID")
+// @IDExample("snap-0123456789abcdef0-123456789012")
+// @IDExample("snap-0123456789abcdef0", valid=false)
+// @IDExample("vol-0123456789abcdef0-123456789012", valid=false)
 func ResourceSnapshotCreateVolumePermission() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSnapshotCreateVolumePermissionCreate,
@@ -25,6 +25,13 @@ import (
 )
 
 // @SDKResource("aws_ami_launch_permission")
+// @IDHelpers(parse="AMILaunchPermissionParseResourceID", create="AMILaunchPermissionCreateResourceID")
+// @IDExample("ami-0123456789abcdef0-123456789012")
+// @IDExample("ami-0123456789abcdef0-group-all")
+// @IDExample("ami-0123456789abcdef0-org-arn:aws:organizations::123456789012:organization/o-a1b2c3d4e5")
+// @IDExample("ami-0123456789abcdef0-ou-arn:aws:organizations::123456789012:ou/o-a1b2c3d4e5/ou-ab12-11111111")
+// @IDExample("ami-0123456789abcdef0", valid=false)
+// @IDExample("ami-0123456789abcdef0-user-123456789012", valid=false)
 func ResourceAMILaunchPermission() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAMILaunchPermissionCreate,
@@ -19,7 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKDataSource("aws_eip", name="EIP)
+// @SDKDataSource("aws_eip", name="EIP")
 // @Tags
 func dataSourceEIP() *schema.Resource {
 	return &schema.Resource{
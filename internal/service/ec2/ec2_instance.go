@@ -41,6 +41,7 @@ import (
 
 // @SDKResource("aws_instance", name="Instance")
 // @Tags(identifierAttribute="id")
+// @ImportFromARN(service="ec2", resource="instance/{id}", id="{id}")
 func ResourceInstance() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -26,6 +26,7 @@ func TestAccEC2InstanceMetadataDefaults_serial(t *testing.T) {
 		acctest.CtBasic: testAccInstanceMetadataDefaults_basic,
 		"disappears":    testAccInstanceMetadataDefaults_disappears,
 		"empty":         testAccInstanceMetadataDefaults_empty,
+		"region":        testAccInstanceMetadataDefaults_region,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -82,6 +83,31 @@ func testAccInstanceMetadataDefaults_empty(t *testing.T) {
 	})
 }
 
+func testAccInstanceMetadataDefaults_region(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_metadata_defaults.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceMetadataDefaultsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceMetadataDefaultsConfig_region(acctest.AlternateRegion()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceMetadataDefaultsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", "required"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, acctest.AlternateRegion()),
+				),
+			},
+		},
+	})
+}
+
 func testAccInstanceMetadataDefaults_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ec2_instance_metadata_defaults.test"
@@ -106,13 +132,18 @@ func testAccInstanceMetadataDefaults_disappears(t *testing.T) {
 
 func testAccCheckInstanceMetadataDefaultsDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
-
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_ec2_instance_metadata_defaults" {
 				continue
 			}
 
+			meta, err := acctest.Provider.Meta().(*conns.AWSClient).ForRegion(ctx, rs.Primary.Attributes[names.AttrRegion])
+			if err != nil {
+				return err
+			}
+
+			conn := meta.EC2Client(ctx)
+
 			output, err := tfec2.FindInstanceMetadataDefaults(ctx, conn)
 
 			if tfresource.NotFound(err) || err == nil && itypes.IsZero(output) {
@@ -132,14 +163,19 @@ func testAccCheckInstanceMetadataDefaultsDestroy(ctx context.Context) resource.T
 
 func testAccCheckInstanceMetadataDefaultsExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		_, ok := s.RootModule().Resources[n]
+		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+		meta, err := acctest.Provider.Meta().(*conns.AWSClient).ForRegion(ctx, rs.Primary.Attributes[names.AttrRegion])
+		if err != nil {
+			return err
+		}
+
+		conn := meta.EC2Client(ctx)
 
-		_, err := tfec2.FindInstanceMetadataDefaults(ctx, conn)
+		_, err = tfec2.FindInstanceMetadataDefaults(ctx, conn)
 
 		return err
 	}
@@ -165,3 +201,13 @@ resource "aws_ec2_instance_metadata_defaults" "test" {
 }
 `
 )
+
+func testAccInstanceMetadataDefaultsConfig_region(region string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_instance_metadata_defaults" "test" {
+  region = %[1]q
+
+  http_tokens = "required" # non-default
+}
+`, region)
+}
@@ -92,6 +92,7 @@ var (
 	FindVPNGatewayRoutePropagationExistsV2                 = findVPNGatewayRoutePropagationExists
 	FlattenNetworkInterfacePrivateIPAddresses              = flattenNetworkInterfacePrivateIPAddresses
 	IPAMServicePrincipal                                   = ipamServicePrincipal
+	MoveLegacySecurityGroupRule                            = (*legacySecurityGroupRuleResourceModel).moveTo
 	NewAttributeFilterList                                 = newAttributeFilterList
 	NewAttributeFilterListV2                               = newAttributeFilterListV2
 	NewCustomFilterList                                    = newCustomFilterList
@@ -104,5 +105,6 @@ var (
 )
 
 type (
-	IPProtocol = ipProtocol
+	IPProtocol                           = ipProtocol
+	LegacySecurityGroupRuleResourceModel = legacySecurityGroupRuleResourceModel
 )
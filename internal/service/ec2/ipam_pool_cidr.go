@@ -26,6 +26,9 @@ import (
 )
 
 // @SDKResource("aws_vpc_ipam_pool_cidr", name="IPAM Pool CIDR")
+// @IDHelpers(parse="IPAMPoolCIDRParseResourceID", create="IPAMPoolCIDRCreateResourceID")
+// @IDExample("10.0.0.0/16_ipam-pool-0123456789abcdef0")
+// @IDExample("10.0.0.0/16", valid=false)
 func resourceIPAMPoolCIDR() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceIPAMPoolCIDRCreate,
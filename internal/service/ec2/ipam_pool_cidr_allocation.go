@@ -25,6 +25,9 @@ import (
 )
 
 // @SDKResource("aws_vpc_ipam_pool_cidr_allocation", name="IPAM Pool CIDR Allocation")
+// @IDHelpers(parse="IPAMPoolCIDRAllocationParseResourceID", create="IPAMPoolCIDRAllocationCreateResourceID")
+// @IDExample("ipam-pool-alloc-0123456789abcdef0_ipam-pool-0123456789abcdef0")
+// @IDExample("ipam-pool-alloc-0123456789abcdef0", valid=false)
 func resourceIPAMPoolCIDRAllocation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceIPAMPoolCIDRAllocationCreate,
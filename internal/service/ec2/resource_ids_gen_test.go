@@ -0,0 +1,795 @@
+// Code generated by internal/generate/idtests/main.go; DO NOT EDIT.
+
+package ec2
+
+import (
+	"testing"
+)
+
+func TestAMILaunchPermissionResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"ami-0123456789abcdef0-123456789012": true,
+		"ami-0123456789abcdef0-group-all":    true,
+		"ami-0123456789abcdef0-org-arn:aws:organizations::123456789012:organization/o-a1b2c3d4e5":       true, //lintignore:AWSAT005
+		"ami-0123456789abcdef0-ou-arn:aws:organizations::123456789012:ou/o-a1b2c3d4e5/ou-ab12-11111111": true, //lintignore:AWSAT005
+		"ami-0123456789abcdef0":                   false,
+		"ami-0123456789abcdef0-user-123456789012": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, part3, part4, err := AMILaunchPermissionParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("AMILaunchPermissionParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("AMILaunchPermissionParseResourceID(%q): %s", id, err)
+			}
+
+			got := AMILaunchPermissionCreateResourceID(part0, part1, part2, part3, part4)
+
+			if want := id; got != want {
+				t.Errorf("AMILaunchPermissionCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestClientVPNAuthorizationRuleResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"cvpn-endpoint-0123456789abcdef0,10.0.0.0/16":          true,
+		"cvpn-endpoint-0123456789abcdef0,10.0.0.0/16,my-group": true,
+		"cvpn-endpoint-0123456789abcdef0":                      false,
+		"cvpn-endpoint-0123456789abcdef0,,my-group":            false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := ClientVPNAuthorizationRuleParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ClientVPNAuthorizationRuleParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ClientVPNAuthorizationRuleParseResourceID(%q): %s", id, err)
+			}
+
+			got := ClientVPNAuthorizationRuleCreateResourceID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("ClientVPNAuthorizationRuleCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestClientVPNRouteResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"cvpn-endpoint-0123456789abcdef0,subnet-0123456789abcdef0,10.0.0.0/16": true,
+		"cvpn-endpoint-0123456789abcdef0,subnet-0123456789abcdef0":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := ClientVPNRouteParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ClientVPNRouteParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ClientVPNRouteParseResourceID(%q): %s", id, err)
+			}
+
+			got := ClientVPNRouteCreateResourceID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("ClientVPNRouteCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestIPAMPoolCIDRResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"10.0.0.0/16_ipam-pool-0123456789abcdef0": true,
+		"10.0.0.0/16": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := IPAMPoolCIDRParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("IPAMPoolCIDRParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("IPAMPoolCIDRParseResourceID(%q): %s", id, err)
+			}
+
+			got := IPAMPoolCIDRCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("IPAMPoolCIDRCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestIPAMPoolCIDRAllocationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"ipam-pool-alloc-0123456789abcdef0_ipam-pool-0123456789abcdef0": true,
+		"ipam-pool-alloc-0123456789abcdef0":                             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := IPAMPoolCIDRAllocationParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("IPAMPoolCIDRAllocationParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("IPAMPoolCIDRAllocationParseResourceID(%q): %s", id, err)
+			}
+
+			got := IPAMPoolCIDRAllocationCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("IPAMPoolCIDRAllocationCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestInternetGatewayAttachmentResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"igw-0123456789abcdef0:vpc-0123456789abcdef0": true,
+		"igw-0123456789abcdef0":                       false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := InternetGatewayAttachmentParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("InternetGatewayAttachmentParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("InternetGatewayAttachmentParseResourceID(%q): %s", id, err)
+			}
+
+			got := InternetGatewayAttachmentCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("InternetGatewayAttachmentCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestManagedPrefixListEntryResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"pl-0123456789abcdef0,10.0.0.0/16": true,
+		"pl-0123456789abcdef0":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ManagedPrefixListEntryParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ManagedPrefixListEntryParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ManagedPrefixListEntryParseResourceID(%q): %s", id, err)
+			}
+
+			got := ManagedPrefixListEntryCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("ManagedPrefixListEntryCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestSnapshotCreateVolumePermissionResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"snap-0123456789abcdef0-123456789012": true,
+		"snap-0123456789abcdef0":              false,
+		"vol-0123456789abcdef0-123456789012":  false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := EBSSnapshotCreateVolumePermissionParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("EBSSnapshotCreateVolumePermissionParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("EBSSnapshotCreateVolumePermissionParseResourceID(%q): %s", id, err)
+			}
+
+			got := EBSSnapshotCreateVolumePermissionCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("EBSSnapshotCreateVolumePermissionCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayMulticastDomainAssociationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"tgw-mcast-domain-0123456789abcdef0/tgw-attach-0123456789abcdef0/subnet-0123456789abcdef0": true,
+		"tgw-mcast-domain-0123456789abcdef0/tgw-attach-0123456789abcdef0":                          false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := TransitGatewayMulticastDomainAssociationParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("TransitGatewayMulticastDomainAssociationParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("TransitGatewayMulticastDomainAssociationParseResourceID(%q): %s", id, err)
+			}
+
+			got := TransitGatewayMulticastDomainAssociationCreateResourceID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("TransitGatewayMulticastDomainAssociationCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayMulticastGroupMemberResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"tgw-mcast-domain-0123456789abcdef0/224.0.0.1/eni-0123456789abcdef0": true,
+		"tgw-mcast-domain-0123456789abcdef0/224.0.0.1":                       false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := TransitGatewayMulticastGroupMemberParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("TransitGatewayMulticastGroupMemberParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("TransitGatewayMulticastGroupMemberParseResourceID(%q): %s", id, err)
+			}
+
+			got := TransitGatewayMulticastGroupMemberCreateResourceID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("TransitGatewayMulticastGroupMemberCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayMulticastGroupSourceResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"tgw-mcast-domain-0123456789abcdef0/224.0.0.1/eni-0123456789abcdef0": true,
+		"tgw-mcast-domain-0123456789abcdef0/224.0.0.1":                       false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := TransitGatewayMulticastGroupSourceParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("TransitGatewayMulticastGroupSourceParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("TransitGatewayMulticastGroupSourceParseResourceID(%q): %s", id, err)
+			}
+
+			got := TransitGatewayMulticastGroupSourceCreateResourceID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("TransitGatewayMulticastGroupSourceCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayPolicyTableAssociationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"tgw-ptb-0123456789abcdef0_tgw-attach-0123456789abcdef0": true,
+		"tgw-ptb-0123456789abcdef0":                              false,
+		"tgw-ptb-0123456789abcdef0_":                             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := TransitGatewayPolicyTableAssociationParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("TransitGatewayPolicyTableAssociationParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("TransitGatewayPolicyTableAssociationParseResourceID(%q): %s", id, err)
+			}
+
+			got := TransitGatewayPolicyTableAssociationCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("TransitGatewayPolicyTableAssociationCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayPrefixListReferenceResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"tgw-rtb-0123456789abcdef0_pl-0123456789abcdef0": true,
+		"tgw-rtb-0123456789abcdef0":                      false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := TransitGatewayPrefixListReferenceParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("TransitGatewayPrefixListReferenceParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("TransitGatewayPrefixListReferenceParseResourceID(%q): %s", id, err)
+			}
+
+			got := TransitGatewayPrefixListReferenceCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("TransitGatewayPrefixListReferenceCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayRouteResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"tgw-rtb-0123456789abcdef0_10.0.0.0/16": true,
+		"tgw-rtb-0123456789abcdef0":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := TransitGatewayRouteParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("TransitGatewayRouteParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("TransitGatewayRouteParseResourceID(%q): %s", id, err)
+			}
+
+			got := TransitGatewayRouteCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("TransitGatewayRouteCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayRouteTableAssociationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"tgw-rtb-0123456789abcdef0_tgw-attach-0123456789abcdef0": true,
+		"tgw-rtb-0123456789abcdef0":                              false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := TransitGatewayRouteTableAssociationParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("TransitGatewayRouteTableAssociationParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("TransitGatewayRouteTableAssociationParseResourceID(%q): %s", id, err)
+			}
+
+			got := TransitGatewayRouteTableAssociationCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("TransitGatewayRouteTableAssociationCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayRouteTablePropagationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"tgw-rtb-0123456789abcdef0_tgw-attach-0123456789abcdef0": true,
+		"tgw-rtb-0123456789abcdef0":                              false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := TransitGatewayRouteTablePropagationParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("TransitGatewayRouteTablePropagationParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("TransitGatewayRouteTablePropagationParseResourceID(%q): %s", id, err)
+			}
+
+			got := TransitGatewayRouteTablePropagationCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("TransitGatewayRouteTablePropagationCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestVPCDHCPOptionsAssociationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"dopt-0123456789abcdef0-vpc-0123456789abcdef0": true,
+		"default-vpc-0123456789abcdef0":                true,
+		"dopt-0123456789abcdef0":                       false,
+		"vpc-0123456789abcdef0":                        false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := VPCDHCPOptionsAssociationParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("VPCDHCPOptionsAssociationParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("VPCDHCPOptionsAssociationParseResourceID(%q): %s", id, err)
+			}
+
+			got := VPCDHCPOptionsAssociationCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("VPCDHCPOptionsAssociationCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestVPCEndpointConnectionAccepterResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"vpce-svc-0123456789abcdef0_vpce-0123456789abcdef0": true,
+		"vpce-svc-0123456789abcdef0":                        false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := VPCEndpointConnectionAccepterParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("VPCEndpointConnectionAccepterParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("VPCEndpointConnectionAccepterParseResourceID(%q): %s", id, err)
+			}
+
+			got := VPCEndpointConnectionAccepterCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("VPCEndpointConnectionAccepterCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestVPNConnectionRouteResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"10.0.0.0/16:vpn-0123456789abcdef0":   true,
+		"10.0.0.0/16":                         false,
+		"2001:db8::/32:vpn-0123456789abcdef0": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := VPNConnectionRouteParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("VPNConnectionRouteParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("VPNConnectionRouteParseResourceID(%q): %s", id, err)
+			}
+
+			got := VPNConnectionRouteCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("VPNConnectionRouteCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestVPNGatewayRoutePropagationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"vgw-0123456789abcdef0_rtb-0123456789abcdef0": true,
+		"vgw-0123456789abcdef0":                       false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := VPNGatewayRoutePropagationParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("VPNGatewayRoutePropagationParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("VPNGatewayRoutePropagationParseID(%q): %s", id, err)
+			}
+
+			got := VPNGatewayRoutePropagationCreateID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("VPNGatewayRoutePropagationCreateID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestVerifiedAccessInstanceTrustProviderAttachmentResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"vai-0123456789abcdef0/vatp-0123456789abcdef0": true,
+		"vai-0123456789abcdef0":                        false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := VerifiedAccessInstanceTrustProviderAttachmentParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("VerifiedAccessInstanceTrustProviderAttachmentParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("VerifiedAccessInstanceTrustProviderAttachmentParseResourceID(%q): %s", id, err)
+			}
+
+			got := VerifiedAccessInstanceTrustProviderAttachmentCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("VerifiedAccessInstanceTrustProviderAttachmentCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
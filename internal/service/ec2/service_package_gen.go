@@ -12,6 +12,13 @@ import (
 
 type servicePackage struct{}
 
+var (
+	newEBSFastSnapshotRestoreResourceIdentity = &types.ServicePackageResourceIdentity{
+		IDAttributes: []string{"availability_zone", "snapshot_id"},
+		IDSeparator:  ",",
+	}
+)
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
 		{
@@ -22,14 +29,19 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newSecurityGroupRulesDataSource,
 			Name:    "Security Group Rules",
 		},
+		{
+			Factory: newVPCDependencyGraphDataSource,
+			Name:    "VPC Dependency Graph",
+		},
 	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
 		{
-			Factory: newEBSFastSnapshotRestoreResource,
-			Name:    "EBS Fast Snapshot Restore",
+			Factory:  newEBSFastSnapshotRestoreResource,
+			Name:     "EBS Fast Snapshot Restore",
+			Identity: newEBSFastSnapshotRestoreResourceIdentity,
 		},
 		{
 			Factory: newEIPDomainNameResource,
@@ -850,6 +862,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Import: &types.ServicePackageResourceImport{
+				ARNService:  "ec2",
+				ARNResource: "instance/{id}",
+				ID:          "{id}",
+			},
 		},
 		{
 			Factory:  ResourceInternetGateway,
@@ -858,6 +875,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Import: &types.ServicePackageResourceImport{
+				ARNService:  "ec2",
+				ARNResource: "internet-gateway/{id}",
+				ID:          "{id}",
+			},
 		},
 		{
 			Factory:  ResourceInternetGatewayAttachment,
@@ -957,6 +979,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Import: &types.ServicePackageResourceImport{
+				ARNService:  "ec2",
+				ARNResource: "security-group/{id}",
+				ID:          "{id}",
+			},
 		},
 		{
 			Factory:  ResourceSecurityGroupRule,
@@ -993,6 +1020,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Import: &types.ServicePackageResourceImport{
+				ARNService:  "ec2",
+				ARNResource: "subnet/{id}",
+				ID:          "{id}",
+			},
 		},
 		{
 			Factory:  ResourceVerifiedAccessEndpoint,
@@ -1047,6 +1079,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Import: &types.ServicePackageResourceImport{
+				ARNService:  "ec2",
+				ARNResource: "vpc/{id}",
+				ID:          "{id}",
+			},
 		},
 		{
 			Factory:  ResourceVPCDHCPOptions,
@@ -22,6 +22,9 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_multicast_domain_association")
+// @IDHelpers(parse="TransitGatewayMulticastDomainAssociationParseResourceID", create="TransitGatewayMulticastDomainAssociationCreateResourceID")
+// @IDExample("tgw-mcast-domain-0123456789abcdef0/tgw-attach-0123456789abcdef0/subnet-0123456789abcdef0")
+// @IDExample("tgw-mcast-domain-0123456789abcdef0/tgw-attach-0123456789abcdef0", valid=false)
 func ResourceTransitGatewayMulticastDomainAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayMulticastDomainAssociationCreate,
@@ -22,6 +22,9 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_multicast_group_member")
+// @IDHelpers(parse="TransitGatewayMulticastGroupMemberParseResourceID", create="TransitGatewayMulticastGroupMemberCreateResourceID")
+// @IDExample("tgw-mcast-domain-0123456789abcdef0/224.0.0.1/eni-0123456789abcdef0")
+// @IDExample("tgw-mcast-domain-0123456789abcdef0/224.0.0.1", valid=false)
 func ResourceTransitGatewayMulticastGroupMember() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayMulticastGroupMemberCreate,
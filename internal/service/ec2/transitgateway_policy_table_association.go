@@ -22,6 +22,10 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_policy_table_association")
+// @IDHelpers(parse="TransitGatewayPolicyTableAssociationParseResourceID", create="TransitGatewayPolicyTableAssociationCreateResourceID")
+// @IDExample("tgw-ptb-0123456789abcdef0_tgw-attach-0123456789abcdef0")
+// @IDExample("tgw-ptb-0123456789abcdef0", valid=false)
+// @IDExample("tgw-ptb-0123456789abcdef0_", valid=false)
 func ResourceTransitGatewayPolicyTableAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayPolicyTableAssociationCreate,
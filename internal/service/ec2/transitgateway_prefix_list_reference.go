@@ -22,6 +22,9 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_prefix_list_reference")
+// @IDHelpers(parse="TransitGatewayPrefixListReferenceParseResourceID", create="TransitGatewayPrefixListReferenceCreateResourceID")
+// @IDExample("tgw-rtb-0123456789abcdef0_pl-0123456789abcdef0")
+// @IDExample("tgw-rtb-0123456789abcdef0", valid=false)
 func ResourceTransitGatewayPrefixListReference() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayPrefixListReferenceCreate,
@@ -23,6 +23,9 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_route")
+// @IDHelpers(parse="TransitGatewayRouteParseResourceID", create="TransitGatewayRouteCreateResourceID")
+// @IDExample("tgw-rtb-0123456789abcdef0_10.0.0.0/16")
+// @IDExample("tgw-rtb-0123456789abcdef0", valid=false)
 func ResourceTransitGatewayRoute() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayRouteCreate,
@@ -22,6 +22,9 @@ import (
 )
 
 // @SDKResource("aws_ec2_transit_gateway_route_table_association")
+// @IDHelpers(parse="TransitGatewayRouteTableAssociationParseResourceID", create="TransitGatewayRouteTableAssociationCreateResourceID")
+// @IDExample("tgw-rtb-0123456789abcdef0_tgw-attach-0123456789abcdef0")
+// @IDExample("tgw-rtb-0123456789abcdef0", valid=false)
 func ResourceTransitGatewayRouteTableAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayRouteTableAssociationCreate,
@@ -21,6 +21,9 @@ import (
 )
 
 // @SDKResource("aws_verifiedaccess_instance_trust_provider_attachment", name="Verified Access Instance Trust Provider Attachment")
+// @IDHelpers(parse="VerifiedAccessInstanceTrustProviderAttachmentParseResourceID", create="VerifiedAccessInstanceTrustProviderAttachmentCreateResourceID")
+// @IDExample("vai-0123456789abcdef0/vatp-0123456789abcdef0")
+// @IDExample("vai-0123456789abcdef0", valid=false)
 func ResourceVerifiedAccessInstanceTrustProviderAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVerifiedAccessInstanceTrustProviderAttachmentCreate,
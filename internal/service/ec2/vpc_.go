@@ -39,6 +39,7 @@ const (
 
 // @SDKResource("aws_vpc", name="VPC")
 // @Tags(identifierAttribute="id")
+// @ImportFromARN(service="ec2", resource="vpc/{id}", id="{id}")
 func ResourceVPC() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -458,6 +459,10 @@ func resourceVPCDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diags
 	}
 
+	if tfawserr_sdkv2.ErrCodeEquals(err, errCodeDependencyViolation) {
+		err = dependencyViolationError(ctx, conn, "vpc-id", d.Id(), err)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting EC2 VPC (%s): %s", d.Id(), err)
 	}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+// dependencyGraphNode is a resource discovered in a VPC.
+// A node depends on the resources identified by dependencies: they must be created before it and destroyed after it.
+type dependencyGraphNode struct {
+	dependencies []string
+	id           string
+	typeName     string
+	vpcID        string
+}
+
+// dependencyGraphOrder returns the specified nodes in dependency order, each node's dependencies before it.
+// If seeds are specified, only those nodes and the nodes that transitively depend on them are returned.
+// Dependencies on resources that are not nodes are ignored.
+func dependencyGraphOrder(nodes []dependencyGraphNode, seeds []string) ([]dependencyGraphNode, error) {
+	g := depgraph.New()
+	byID := make(map[string]dependencyGraphNode, len(nodes))
+
+	for _, v := range nodes {
+		g.AddNode(v.id)
+		byID[v.id] = v
+	}
+
+	for _, v := range nodes {
+		var dependencies []string
+
+		for _, dependency := range v.dependencies {
+			if !g.HasNode(dependency) || slices.Contains(dependencies, dependency) || dependency == v.id {
+				continue
+			}
+
+			if err := g.AddDependency(v.id, dependency); err != nil {
+				return nil, err
+			}
+
+			dependencies = append(dependencies, dependency)
+		}
+
+		v.dependencies = dependencies
+		byID[v.id] = v
+	}
+
+	order, err := g.OverallOrder()
+
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]struct{})
+
+	for _, seed := range seeds {
+		if !g.HasNode(seed) {
+			return nil, fmt.Errorf("resource (%s) not found", seed)
+		}
+
+		dependents, err := g.DependentsOf(seed)
+
+		if err != nil {
+			return nil, err
+		}
+
+		selected[seed] = struct{}{}
+		for _, v := range dependents {
+			selected[v] = struct{}{}
+		}
+	}
+
+	var output []dependencyGraphNode
+
+	for _, id := range order {
+		if _, ok := selected[id]; ok || len(seeds) == 0 {
+			output = append(output, byID[id])
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,460 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="VPC Dependency Graph")
+func newVPCDependencyGraphDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &vpcDependencyGraphDataSource{}, nil
+}
+
+type vpcDependencyGraphDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *vpcDependencyGraphDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_vpc_dependency_graph"
+}
+
+func (d *vpcDependencyGraphDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"destroy_order": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"nodes": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dependencyGraphNodeModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"dependencies":  fwtypes.ListOfStringType,
+						names.AttrID:    types.StringType,
+						names.AttrType:  types.StringType,
+						names.AttrVPCID: types.StringType,
+					},
+				},
+			},
+			names.AttrVPCID: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *vpcDependencyGraphDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("arns"),
+			path.MatchRoot(names.AttrVPCID),
+		),
+	}
+}
+
+func (d *vpcDependencyGraphDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data vpcDependencyGraphDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	meta := d.Meta()
+
+	var seeds, vpcIDs []string
+
+	if vpcID := data.VPCID.ValueString(); vpcID != "" {
+		seeds = append(seeds, vpcID)
+		vpcIDs = append(vpcIDs, vpcID)
+	} else {
+		for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.ARNs) {
+			id, vpcID, err := dependencyGraphNodeIDForARN(ctx, meta, v)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("reading VPC Dependency Graph resource (%s)", v), err.Error())
+
+				return
+			}
+
+			seeds = append(seeds, id)
+			if !slices.Contains(vpcIDs, vpcID) {
+				vpcIDs = append(vpcIDs, vpcID)
+			}
+		}
+	}
+
+	var nodes []dependencyGraphNode
+
+	for _, vpcID := range vpcIDs {
+		v, err := findVPCDependencyGraphNodes(ctx, meta, vpcID)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading VPC Dependency Graph (%s)", vpcID), err.Error())
+
+			return
+		}
+
+		nodes = append(nodes, v...)
+	}
+
+	nodes, err := dependencyGraphOrder(nodes, seeds)
+
+	if err != nil {
+		response.Diagnostics.AddError("ordering VPC Dependency Graph", err.Error())
+
+		return
+	}
+
+	var (
+		nodeModels   []*dependencyGraphNodeModel
+		destroyOrder []string
+	)
+
+	for _, v := range nodes {
+		nodeModels = append(nodeModels, &dependencyGraphNodeModel{
+			Dependencies: fwflex.FlattenFrameworkStringValueListOfString(ctx, v.dependencies),
+			ID:           types.StringValue(v.id),
+			Type:         types.StringValue(v.typeName),
+			VPCID:        types.StringValue(v.vpcID),
+		})
+		destroyOrder = append(destroyOrder, v.id)
+	}
+	slices.Reverse(destroyOrder)
+
+	data.DestroyOrder = fwflex.FlattenFrameworkStringValueListOfString(ctx, destroyOrder)
+	data.ID = types.StringValue(strings.Join(seeds, ","))
+	data.Nodes = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, nodeModels)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type vpcDependencyGraphDataSourceModel struct {
+	ARNs         fwtypes.SetValueOf[types.String]                          `tfsdk:"arns"`
+	DestroyOrder fwtypes.ListValueOf[types.String]                         `tfsdk:"destroy_order"`
+	ID           types.String                                              `tfsdk:"id"`
+	Nodes        fwtypes.ListNestedObjectValueOf[dependencyGraphNodeModel] `tfsdk:"nodes"`
+	VPCID        types.String                                              `tfsdk:"vpc_id"`
+}
+
+type dependencyGraphNodeModel struct {
+	Dependencies fwtypes.ListValueOf[types.String] `tfsdk:"dependencies"`
+	ID           types.String                      `tfsdk:"id"`
+	Type         types.String                      `tfsdk:"type"`
+	VPCID        types.String                      `tfsdk:"vpc_id"`
+}
+
+// findVPCDependencyGraphNodes discovers the resources in the specified VPC and their dependencies.
+func findVPCDependencyGraphNodes(ctx context.Context, meta *conns.AWSClient, vpcID string) ([]dependencyGraphNode, error) {
+	conn := meta.EC2Client(ctx)
+
+	vpc, err := findVPCByIDV2(ctx, conn, vpcID)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading EC2 VPC (%s): %w", vpcID, err)
+	}
+
+	nodes := []dependencyGraphNode{{
+		id:       aws.ToString(vpc.VpcId),
+		typeName: "aws_vpc",
+		vpcID:    vpcID,
+	}}
+	sortByID := func(v []dependencyGraphNode) []dependencyGraphNode {
+		slices.SortFunc(v, func(a, b dependencyGraphNode) int {
+			return cmp.Compare(a.id, b.id)
+		})
+
+		return v
+	}
+	filters := newAttributeFilterListV2(map[string]string{
+		"vpc-id": vpcID,
+	})
+
+	subnets, err := findSubnetsV2(ctx, conn, &ec2.DescribeSubnetsInput{Filters: filters})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading EC2 Subnets: %w", err)
+	}
+
+	var subnetNodes []dependencyGraphNode
+	for _, v := range subnets {
+		subnetNodes = append(subnetNodes, dependencyGraphNode{
+			dependencies: []string{vpcID},
+			id:           aws.ToString(v.SubnetId),
+			typeName:     "aws_subnet",
+			vpcID:        vpcID,
+		})
+	}
+	nodes = append(nodes, sortByID(subnetNodes)...)
+
+	securityGroups, err := findSecurityGroupsV2(ctx, conn, &ec2.DescribeSecurityGroupsInput{Filters: filters})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading EC2 Security Groups: %w", err)
+	}
+
+	var securityGroupNodes []dependencyGraphNode
+	for _, v := range securityGroups {
+		securityGroupNodes = append(securityGroupNodes, dependencyGraphNode{
+			dependencies: []string{vpcID},
+			id:           aws.ToString(v.GroupId),
+			typeName:     "aws_security_group",
+			vpcID:        vpcID,
+		})
+	}
+	nodes = append(nodes, sortByID(securityGroupNodes)...)
+
+	// Resources that own network interfaces, keyed by owner.
+	owners := make(map[networkInterfaceOwner]string)
+
+	vpcEndpoints, err := findVPCEndpointsV2(ctx, conn, &ec2.DescribeVpcEndpointsInput{Filters: filters})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading EC2 VPC Endpoints: %w", err)
+	}
+
+	var vpcEndpointNodes []dependencyGraphNode
+	for _, v := range vpcEndpoints {
+		id := aws.ToString(v.VpcEndpointId)
+		dependencies := append([]string{vpcID}, v.SubnetIds...)
+		for _, v := range v.Groups {
+			dependencies = append(dependencies, aws.ToString(v.GroupId))
+		}
+
+		vpcEndpointNodes = append(vpcEndpointNodes, dependencyGraphNode{
+			dependencies: dependencies,
+			id:           id,
+			typeName:     "aws_vpc_endpoint",
+			vpcID:        vpcID,
+		})
+		owners[networkInterfaceOwner{Type: networkInterfaceOwnerTypeVPCEndpoint, ID: id}] = id
+	}
+
+	var loadBalancerNodes []dependencyGraphNode
+	pages := elasticloadbalancingv2.NewDescribeLoadBalancersPaginator(meta.ELBV2Client(ctx), &elasticloadbalancingv2.DescribeLoadBalancersInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading ELBv2 Load Balancers: %w", err)
+		}
+
+		for _, v := range page.LoadBalancers {
+			if aws.ToString(v.VpcId) != vpcID {
+				continue
+			}
+
+			id := aws.ToString(v.LoadBalancerArn)
+			dependencies := slices.Clone(v.SecurityGroups)
+			for _, v := range v.AvailabilityZones {
+				dependencies = append(dependencies, aws.ToString(v.SubnetId))
+			}
+
+			loadBalancerNodes = append(loadBalancerNodes, dependencyGraphNode{
+				dependencies: dependencies,
+				id:           id,
+				typeName:     "aws_lb",
+				vpcID:        vpcID,
+			})
+			if _, suffix, ok := strings.Cut(id, ":loadbalancer/"); ok {
+				owners[networkInterfaceOwner{Type: networkInterfaceOwnerTypeLoadBalancer, ID: suffix}] = id
+			}
+		}
+	}
+
+	var functionNodes []dependencyGraphNode
+	functionPages := lambda.NewListFunctionsPaginator(meta.LambdaClient(ctx), &lambda.ListFunctionsInput{})
+	for functionPages.HasMorePages() {
+		page, err := functionPages.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading Lambda Functions: %w", err)
+		}
+
+		for _, v := range page.Functions {
+			if v.VpcConfig == nil || aws.ToString(v.VpcConfig.VpcId) != vpcID {
+				continue
+			}
+
+			id := aws.ToString(v.FunctionArn)
+
+			functionNodes = append(functionNodes, dependencyGraphNode{
+				dependencies: append(slices.Clone(v.VpcConfig.SubnetIds), v.VpcConfig.SecurityGroupIds...),
+				id:           id,
+				typeName:     "aws_lambda_function",
+				vpcID:        vpcID,
+			})
+			owners[networkInterfaceOwner{Type: networkInterfaceOwnerTypeLambdaFunction, ID: aws.ToString(v.FunctionName)}] = id
+		}
+	}
+
+	networkInterfaces, err := findNetworkInterfacesV2(ctx, conn, &ec2.DescribeNetworkInterfacesInput{Filters: filters})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading EC2 Network Interfaces: %w", err)
+	}
+
+	var networkInterfaceNodes []dependencyGraphNode
+	// Resources that own network interfaces depend on them: the interfaces are deleted with their owner.
+	ownerDependencies := make(map[string][]string)
+	for _, v := range networkInterfaces {
+		id := aws.ToString(v.NetworkInterfaceId)
+		dependencies := []string{aws.ToString(v.SubnetId)}
+		for _, v := range v.Groups {
+			dependencies = append(dependencies, aws.ToString(v.GroupId))
+		}
+
+		networkInterfaceNodes = append(networkInterfaceNodes, dependencyGraphNode{
+			dependencies: dependencies,
+			id:           id,
+			typeName:     "aws_network_interface",
+			vpcID:        vpcID,
+		})
+
+		if owner, ok := networkInterfaceOwnerOf(&v); ok {
+			if ownerID, ok := owners[owner]; ok {
+				ownerDependencies[ownerID] = append(ownerDependencies[ownerID], id)
+			}
+		}
+	}
+	nodes = append(nodes, sortByID(networkInterfaceNodes)...)
+
+	for _, v := range [][]dependencyGraphNode{vpcEndpointNodes, loadBalancerNodes, functionNodes} {
+		for i, node := range v {
+			v[i].dependencies = append(node.dependencies, ownerDependencies[node.id]...)
+		}
+		nodes = append(nodes, sortByID(v)...)
+	}
+
+	return nodes, nil
+}
+
+// dependencyGraphNodeIDForARN returns the dependency graph node ID and VPC ID of the resource with the specified ARN.
+func dependencyGraphNodeIDForARN(ctx context.Context, meta *conns.AWSClient, s string) (string, string, error) {
+	arn, err := arn.Parse(s)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	switch arn.Service {
+	case names.EC2:
+		resourceType, id, _ := strings.Cut(arn.Resource, "/")
+		conn := meta.EC2Client(ctx)
+
+		switch resourceType {
+		case "vpc":
+			return id, id, nil
+
+		case "subnet":
+			output, err := findSubnetsV2(ctx, conn, &ec2.DescribeSubnetsInput{SubnetIds: []string{id}})
+
+			if err != nil {
+				return "", "", err
+			}
+
+			subnet, err := tfresource.AssertSingleValueResult(output)
+
+			if err != nil {
+				return "", "", err
+			}
+
+			return id, aws.ToString(subnet.VpcId), nil
+
+		case "security-group":
+			securityGroup, err := findSecurityGroupV2(ctx, conn, &ec2.DescribeSecurityGroupsInput{GroupIds: []string{id}})
+
+			if err != nil {
+				return "", "", err
+			}
+
+			return id, aws.ToString(securityGroup.VpcId), nil
+
+		case "network-interface":
+			networkInterface, err := findNetworkInterfaceByIDV2(ctx, conn, id)
+
+			if err != nil {
+				return "", "", err
+			}
+
+			return id, aws.ToString(networkInterface.VpcId), nil
+
+		case "vpc-endpoint":
+			vpcEndpoint, err := findVPCEndpointByIDV2(ctx, conn, id)
+
+			if err != nil {
+				return "", "", err
+			}
+
+			return id, aws.ToString(vpcEndpoint.VpcId), nil
+		}
+
+	case "elasticloadbalancing":
+		output, err := meta.ELBV2Client(ctx).DescribeLoadBalancers(ctx, &elasticloadbalancingv2.DescribeLoadBalancersInput{
+			LoadBalancerArns: []string{s},
+		})
+
+		if err != nil {
+			return "", "", err
+		}
+
+		loadBalancer, err := tfresource.AssertSingleValueResult(output.LoadBalancers)
+
+		if err != nil {
+			return "", "", err
+		}
+
+		return aws.ToString(loadBalancer.LoadBalancerArn), aws.ToString(loadBalancer.VpcId), nil
+
+	case names.Lambda:
+		output, err := meta.LambdaClient(ctx).GetFunctionConfiguration(ctx, &lambda.GetFunctionConfigurationInput{
+			FunctionName: aws.String(s),
+		})
+
+		if err != nil {
+			return "", "", err
+		}
+
+		if output.VpcConfig == nil || aws.ToString(output.VpcConfig.VpcId) == "" {
+			return "", "", fmt.Errorf("Lambda Function (%s) is not connected to a VPC", s)
+		}
+
+		// Dependency graph nodes are identified by unqualified function ARN.
+		id := strings.TrimSuffix(aws.ToString(output.FunctionArn), ":"+aws.ToString(output.Version))
+
+		return id, aws.ToString(output.VpcConfig.VpcId), nil
+	}
+
+	return "", "", fmt.Errorf("unsupported resource type: %s", arn.Resource)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCDependencyGraphDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_dependency_graph.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCDependencyGraphDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The VPC, its subnet, its default security group and the test security group.
+					resource.TestCheckResourceAttr(dataSourceName, "nodes.#", acctest.Ct4),
+					resource.TestCheckResourceAttrPair(dataSourceName, "nodes.0.id", "aws_vpc.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "nodes.0.type", "aws_vpc"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "nodes.*.id", "aws_subnet.test.0", names.AttrID),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "nodes.*.id", "aws_security_group.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "destroy_order.#", acctest.Ct4),
+					resource.TestCheckResourceAttrPair(dataSourceName, "destroy_order.3", "aws_vpc.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCDependencyGraphDataSource_arns(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_dependency_graph.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCDependencyGraphDataSourceConfig_arns(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "nodes.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "nodes.0.id", "aws_subnet.test.0", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "nodes.0.type", "aws_subnet"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "nodes.0.vpc_id", "aws_vpc.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "nodes.0.dependencies.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "nodes.0.dependencies.0", "aws_vpc.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "destroy_order.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCDependencyGraphDataSourceConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCDependencyGraphDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCDependencyGraphDataSourceConfig_base(rName), `
+data "aws_vpc_dependency_graph" "test" {
+  vpc_id = aws_vpc.test.id
+
+  depends_on = [aws_subnet.test, aws_security_group.test]
+}
+`)
+}
+
+func testAccVPCDependencyGraphDataSourceConfig_arns(rName string) string {
+	return acctest.ConfigCompose(testAccVPCDependencyGraphDataSourceConfig_base(rName), `
+data "aws_vpc_dependency_graph" "test" {
+  arns = [aws_subnet.test[0].arn]
+}
+`)
+}
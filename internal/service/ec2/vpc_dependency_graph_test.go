@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDependencyGraphOrder(t *testing.T) {
+	t.Parallel()
+
+	nodes := []dependencyGraphNode{
+		{id: "vpc-1", typeName: "aws_vpc"},
+		{id: "subnet-1", typeName: "aws_subnet", dependencies: []string{"vpc-1"}},
+		{id: "subnet-2", typeName: "aws_subnet", dependencies: []string{"vpc-1"}},
+		{id: "sg-1", typeName: "aws_security_group", dependencies: []string{"vpc-1"}},
+		{id: "eni-1", typeName: "aws_network_interface", dependencies: []string{"subnet-1", "sg-1", "sg-1"}},
+		{id: "eni-2", typeName: "aws_network_interface", dependencies: []string{"subnet-2", "sg-external"}},
+		{id: "function-1", typeName: "aws_lambda_function", dependencies: []string{"subnet-1", "sg-1", "eni-1"}},
+	}
+
+	testCases := map[string]struct {
+		seeds    []string
+		expected []string
+		wantErr  bool
+	}{
+		"no seeds": {
+			expected: []string{"vpc-1", "subnet-2", "eni-2", "subnet-1", "sg-1", "eni-1", "function-1"},
+		},
+		"VPC": {
+			seeds:    []string{"vpc-1"},
+			expected: []string{"vpc-1", "subnet-2", "eni-2", "subnet-1", "sg-1", "eni-1", "function-1"},
+		},
+		"subnet": {
+			seeds:    []string{"subnet-2"},
+			expected: []string{"subnet-2", "eni-2"},
+		},
+		"security group": {
+			seeds:    []string{"sg-1"},
+			expected: []string{"sg-1", "eni-1", "function-1"},
+		},
+		"multiple": {
+			seeds:    []string{"function-1", "subnet-2"},
+			expected: []string{"subnet-2", "eni-2", "function-1"},
+		},
+		"not found": {
+			seeds:   []string{"subnet-3"},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := dependencyGraphOrder(nodes, testCase.seeds)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("dependencyGraphOrder() err %t, want %t: %v", got, want, err)
+			}
+
+			var got []string
+			seen := make(map[string]struct{})
+			for _, v := range output {
+				got = append(got, v.id)
+				for _, dependency := range v.dependencies {
+					if _, ok := seen[dependency]; !ok && slicesContainsID(output, dependency) {
+						t.Errorf("%s is ordered before its dependency %s", v.id, dependency)
+					}
+				}
+				seen[v.id] = struct{}{}
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDependencyGraphOrderIgnoresUnknownDependencies(t *testing.T) {
+	t.Parallel()
+
+	output, err := dependencyGraphOrder([]dependencyGraphNode{
+		{id: "eni-1", dependencies: []string{"subnet-external", "eni-1"}},
+	}, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(output), 1; got != want {
+		t.Fatalf("got %d nodes, want %d", got, want)
+	}
+
+	if got := output[0].dependencies; len(got) != 0 {
+		t.Errorf("dependencies = %v, want none", got)
+	}
+}
+
+func slicesContainsID(nodes []dependencyGraphNode, id string) bool {
+	for _, v := range nodes {
+		if v.id == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// dependencyViolationDependents are the resources that can prevent deletion of a VPC, subnet or security group.
+type dependencyViolationDependents struct {
+	natGateways        []awstypes.NatGateway
+	networkInterfaces  []awstypes.NetworkInterface
+	securityGroupRules []awstypes.SecurityGroupRule
+	vpcEndpoints       []awstypes.VpcEndpoint
+}
+
+// descriptions returns a human-readable description of each dependent resource,
+// e.g. "ENI eni-0123456789abcdef0 owned by Lambda function my-function".
+func (d *dependencyViolationDependents) descriptions() []string {
+	var descriptions []string
+
+	for _, v := range d.networkInterfaces {
+		id := aws.ToString(v.NetworkInterfaceId)
+
+		if owner, ok := networkInterfaceOwnerOf(&v); ok {
+			descriptions = append(descriptions, fmt.Sprintf("ENI %s owned by %s", id, owner))
+		} else if description := aws.ToString(v.Description); description != "" {
+			descriptions = append(descriptions, fmt.Sprintf("ENI %s (%s)", id, description))
+		} else {
+			descriptions = append(descriptions, fmt.Sprintf("ENI %s", id))
+		}
+	}
+
+	for _, v := range d.natGateways {
+		if v.State == awstypes.NatGatewayStateDeleted {
+			continue
+		}
+
+		descriptions = append(descriptions, fmt.Sprintf("NAT gateway %s", aws.ToString(v.NatGatewayId)))
+	}
+
+	for _, v := range d.vpcEndpoints {
+		if state := strings.ToLower(string(v.State)); state == vpcEndpointStateDeleted {
+			continue
+		}
+
+		descriptions = append(descriptions, fmt.Sprintf("VPC endpoint %s (%s)", aws.ToString(v.VpcEndpointId), aws.ToString(v.ServiceName)))
+	}
+
+	for _, v := range d.securityGroupRules {
+		descriptions = append(descriptions, fmt.Sprintf("security group rule %s in %s", aws.ToString(v.SecurityGroupRuleId), aws.ToString(v.GroupId)))
+	}
+
+	return descriptions
+}
+
+// findDependencyViolationDependents returns the resources that can prevent deletion of the VPC, subnet or security group with the specified ID.
+// filterName is the EC2 API filter name matching the resource's ID: "vpc-id", "subnet-id" or "group-id".
+func findDependencyViolationDependents(ctx context.Context, conn *ec2.Client, filterName, id string) (*dependencyViolationDependents, error) {
+	var output dependencyViolationDependents
+	filters := newAttributeFilterListV2(map[string]string{
+		filterName: id,
+	})
+
+	networkInterfaces, err := findNetworkInterfacesV2(ctx, conn, &ec2.DescribeNetworkInterfacesInput{
+		Filters: filters,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading EC2 Network Interfaces: %w", err)
+	}
+
+	output.networkInterfaces = networkInterfaces
+
+	switch filterName {
+	case "vpc-id", "subnet-id":
+		natGateways, err := findNATGatewaysV2(ctx, conn, &ec2.DescribeNatGatewaysInput{
+			Filter: filters,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading EC2 NAT Gateways: %w", err)
+		}
+
+		output.natGateways = natGateways
+	}
+
+	switch filterName {
+	case "vpc-id":
+		// Interface endpoints in a subnet or security group are found via their network interfaces.
+		vpcEndpoints, err := findVPCEndpointsV2(ctx, conn, &ec2.DescribeVpcEndpointsInput{
+			Filters: filters,
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading EC2 VPC Endpoints: %w", err)
+		}
+
+		output.vpcEndpoints = vpcEndpoints
+
+	case "group-id":
+		securityGroupRules, err := findSecurityGroupRulesReferencingSecurityGroup(ctx, conn, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output.securityGroupRules = securityGroupRules
+	}
+
+	return &output, nil
+}
+
+// findSecurityGroupRulesReferencingSecurityGroup returns the rules in other security groups that reference the specified security group.
+func findSecurityGroupRulesReferencingSecurityGroup(ctx context.Context, conn *ec2.Client, id string) ([]awstypes.SecurityGroupRule, error) {
+	var groupIDs []string
+
+	for _, filterName := range []string{"ip-permission.group-id", "egress.ip-permission.group-id"} {
+		securityGroups, err := findSecurityGroupsV2(ctx, conn, &ec2.DescribeSecurityGroupsInput{
+			Filters: newAttributeFilterListV2(map[string]string{
+				filterName: id,
+			}),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading EC2 Security Groups: %w", err)
+		}
+
+		for _, v := range securityGroups {
+			if groupID := aws.ToString(v.GroupId); groupID != id {
+				groupIDs = tfslices.AppendUnique(groupIDs, groupID)
+			}
+		}
+	}
+
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+
+	securityGroupRules, err := findSecurityGroupRulesV2(ctx, conn, &ec2.DescribeSecurityGroupRulesInput{
+		Filters: []awstypes.Filter{{
+			Name:   aws.String("group-id"),
+			Values: groupIDs,
+		}},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading EC2 Security Group Rules: %w", err)
+	}
+
+	return tfslices.Filter(securityGroupRules, func(v awstypes.SecurityGroupRule) bool {
+		return v.ReferencedGroupInfo != nil && aws.ToString(v.ReferencedGroupInfo.GroupId) == id
+	}), nil
+}
+
+// dependencyViolationError annotates a DependencyViolation error returned when deleting the VPC, subnet or security group
+// with the specified ID with the resources that still depend on it.
+// If the dependents can't be found, the original error is returned.
+func dependencyViolationError(ctx context.Context, conn *ec2.Client, filterName, id string, err error) error {
+	dependents, findErr := findDependencyViolationDependents(ctx, conn, filterName, id)
+
+	if findErr != nil {
+		tflog.Warn(ctx, "Finding dependent resources", map[string]any{
+			"error": findErr.Error(),
+		})
+
+		return err
+	}
+
+	if descriptions := dependents.descriptions(); len(descriptions) > 0 {
+		return errors.Join(err, fmt.Errorf("dependent resources: %s", strings.Join(descriptions, ", ")))
+	}
+
+	return err
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDependencyViolationDependentsDescriptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dependents dependencyViolationDependents
+		expected   []string
+	}{
+		"none": {},
+		"network interfaces": {
+			dependents: dependencyViolationDependents{
+				networkInterfaces: []awstypes.NetworkInterface{
+					{
+						Description:        aws.String("AWS Lambda VPC ENI-my-function-1b2c3d4e-aaaa-bbbb-cccc-0123456789ab"),
+						InterfaceType:      awstypes.NetworkInterfaceTypeLambda,
+						NetworkInterfaceId: aws.String("eni-1"),
+					},
+					{
+						Description:        aws.String("my interface"),
+						NetworkInterfaceId: aws.String("eni-2"),
+					},
+					{
+						NetworkInterfaceId: aws.String("eni-3"),
+					},
+				},
+			},
+			expected: []string{
+				"ENI eni-1 owned by Lambda function my-function",
+				"ENI eni-2 (my interface)",
+				"ENI eni-3",
+			},
+		},
+		"NAT gateways": {
+			dependents: dependencyViolationDependents{
+				natGateways: []awstypes.NatGateway{
+					{
+						NatGatewayId: aws.String("nat-1"),
+						State:        awstypes.NatGatewayStateAvailable,
+					},
+					{
+						NatGatewayId: aws.String("nat-2"),
+						State:        awstypes.NatGatewayStateDeleted,
+					},
+				},
+			},
+			expected: []string{
+				"NAT gateway nat-1",
+			},
+		},
+		"VPC endpoints": {
+			dependents: dependencyViolationDependents{
+				vpcEndpoints: []awstypes.VpcEndpoint{
+					{
+						ServiceName:   aws.String("com.amazonaws.us-west-2.s3"),
+						State:         awstypes.StateAvailable,
+						VpcEndpointId: aws.String("vpce-1"),
+					},
+					{
+						ServiceName:   aws.String("com.amazonaws.us-west-2.s3"),
+						State:         awstypes.StateDeleted,
+						VpcEndpointId: aws.String("vpce-2"),
+					},
+				},
+			},
+			expected: []string{
+				"VPC endpoint vpce-1 (com.amazonaws.us-west-2.s3)",
+			},
+		},
+		"security group rules": {
+			dependents: dependencyViolationDependents{
+				securityGroupRules: []awstypes.SecurityGroupRule{
+					{
+						GroupId:             aws.String("sg-2"),
+						SecurityGroupRuleId: aws.String("sgr-1"),
+					},
+				},
+			},
+			expected: []string{
+				"security group rule sgr-1 in sg-2",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.dependents.descriptions()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,11 @@ import (
 )
 
 // @SDKResource("aws_vpc_dhcp_options_association")
+// @IDHelpers(parse="VPCDHCPOptionsAssociationParseResourceID", create="VPCDHCPOptionsAssociationCreateResourceID")
+// @IDExample("dopt-0123456789abcdef0-vpc-0123456789abcdef0")
+// @IDExample("default-vpc-0123456789abcdef0")
+// @IDExample("dopt-0123456789abcdef0", valid=false)
+// @IDExample("vpc-0123456789abcdef0", valid=false)
 func ResourceVPCDHCPOptionsAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCDHCPOptionsAssociationPut,
@@ -21,6 +21,9 @@ import (
 )
 
 // @SDKResource("aws_vpc_endpoint_connection_accepter")
+// @IDHelpers(parse="VPCEndpointConnectionAccepterParseResourceID", create="VPCEndpointConnectionAccepterCreateResourceID")
+// @IDExample("vpce-svc-0123456789abcdef0_vpce-0123456789abcdef0")
+// @IDExample("vpce-svc-0123456789abcdef0", valid=false)
 func ResourceVPCEndpointConnectionAccepter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCEndpointConnectionAccepterCreate,
@@ -20,6 +20,9 @@ import (
 )
 
 // @SDKResource("aws_internet_gateway_attachment")
+// @IDHelpers(parse="InternetGatewayAttachmentParseResourceID", create="InternetGatewayAttachmentCreateResourceID")
+// @IDExample("igw-0123456789abcdef0:vpc-0123456789abcdef0")
+// @IDExample("igw-0123456789abcdef0", valid=false)
 func ResourceInternetGatewayAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceInternetGatewayAttachmentCreate,
@@ -21,6 +21,9 @@ import (
 )
 
 // @SDKResource("aws_ec2_managed_prefix_list_entry")
+// @IDHelpers(parse="ManagedPrefixListEntryParseResourceID", create="ManagedPrefixListEntryCreateResourceID")
+// @IDExample("pl-0123456789abcdef0,10.0.0.0/16")
+// @IDExample("pl-0123456789abcdef0", valid=false)
 func ResourceManagedPrefixListEntry() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type networkInterfaceOwnerType string
+
+const (
+	networkInterfaceOwnerTypeClassicLoadBalancer networkInterfaceOwnerType = "Classic Load Balancer"
+	networkInterfaceOwnerTypeInstance            networkInterfaceOwnerType = "EC2 instance"
+	networkInterfaceOwnerTypeLambdaFunction      networkInterfaceOwnerType = "Lambda function"
+	networkInterfaceOwnerTypeLoadBalancer        networkInterfaceOwnerType = "load balancer"
+	networkInterfaceOwnerTypeNATGateway          networkInterfaceOwnerType = "NAT gateway"
+	networkInterfaceOwnerTypeVPCEndpoint         networkInterfaceOwnerType = "VPC endpoint"
+)
+
+// networkInterfaceOwner identifies the resource that created or uses a network interface.
+type networkInterfaceOwner struct {
+	Type networkInterfaceOwnerType
+	// ID is the owning resource's identifier, e.g. a Lambda function name or an ELBv2 load balancer's `app/NAME/ID` ARN suffix.
+	ID string
+}
+
+func (o networkInterfaceOwner) String() string {
+	return fmt.Sprintf("%s %s", o.Type, o.ID)
+}
+
+var (
+	// Hyperplane ENIs created by Lambda have descriptions of the form "AWS Lambda VPC ENI-FUNCTIONNAME-UUID".
+	networkInterfaceLambdaDescriptionRegexp = regexache.MustCompile(`^AWS Lambda VPC ENI-(.+)-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	// ENIs created by Elastic Load Balancing have descriptions of the form "ELB app/NAME/ID", "ELB net/NAME/ID" or "ELB NAME".
+	networkInterfaceELBDescriptionRegexp = regexache.MustCompile(`^ELB ((?:app|gwy|net)/[0-9A-Za-z-]+/[0-9a-f]+|[0-9A-Za-z-]+)$`)
+)
+
+// networkInterfaceOwnerOf returns the resource that created or uses the specified network interface.
+// The owner is determined from the interface's type, description and attachment.
+func networkInterfaceOwnerOf(v *awstypes.NetworkInterface) (networkInterfaceOwner, bool) {
+	description := aws.ToString(v.Description)
+
+	if m := networkInterfaceLambdaDescriptionRegexp.FindStringSubmatch(description); m != nil {
+		return networkInterfaceOwner{Type: networkInterfaceOwnerTypeLambdaFunction, ID: m[1]}, true
+	}
+
+	if m := networkInterfaceELBDescriptionRegexp.FindStringSubmatch(description); m != nil {
+		if strings.Contains(m[1], "/") {
+			return networkInterfaceOwner{Type: networkInterfaceOwnerTypeLoadBalancer, ID: m[1]}, true
+		}
+
+		return networkInterfaceOwner{Type: networkInterfaceOwnerTypeClassicLoadBalancer, ID: m[1]}, true
+	}
+
+	switch v.InterfaceType {
+	case awstypes.NetworkInterfaceTypeVpcEndpoint, awstypes.NetworkInterfaceTypeGatewayLoadBalancerEndpoint:
+		if id, ok := strings.CutPrefix(description, "VPC Endpoint Interface "); ok {
+			return networkInterfaceOwner{Type: networkInterfaceOwnerTypeVPCEndpoint, ID: id}, true
+		}
+	case awstypes.NetworkInterfaceTypeNatGateway:
+		if id, ok := strings.CutPrefix(description, "Interface for NAT Gateway "); ok {
+			return networkInterfaceOwner{Type: networkInterfaceOwnerTypeNATGateway, ID: id}, true
+		}
+	}
+
+	if v := v.Attachment; v != nil && aws.ToString(v.InstanceId) != "" {
+		return networkInterfaceOwner{Type: networkInterfaceOwnerTypeInstance, ID: aws.ToString(v.InstanceId)}, true
+	}
+
+	return networkInterfaceOwner{}, false
+}
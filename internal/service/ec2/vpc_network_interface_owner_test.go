@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestNetworkInterfaceOwnerOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		networkInterface awstypes.NetworkInterface
+		expected         networkInterfaceOwner
+		expectedOK       bool
+	}{
+		"empty": {},
+		"Lambda function": {
+			networkInterface: awstypes.NetworkInterface{
+				Description:   aws.String("AWS Lambda VPC ENI-my-function-1b2c3d4e-aaaa-bbbb-cccc-0123456789ab"),
+				InterfaceType: awstypes.NetworkInterfaceTypeLambda,
+			},
+			expected:   networkInterfaceOwner{Type: networkInterfaceOwnerTypeLambdaFunction, ID: "my-function"},
+			expectedOK: true,
+		},
+		"application load balancer": {
+			networkInterface: awstypes.NetworkInterface{
+				Description: aws.String("ELB app/my-alb/50dc6c495c0c9188"),
+			},
+			expected:   networkInterfaceOwner{Type: networkInterfaceOwnerTypeLoadBalancer, ID: "app/my-alb/50dc6c495c0c9188"},
+			expectedOK: true,
+		},
+		"Classic Load Balancer": {
+			networkInterface: awstypes.NetworkInterface{
+				Description: aws.String("ELB my-elb"),
+			},
+			expected:   networkInterfaceOwner{Type: networkInterfaceOwnerTypeClassicLoadBalancer, ID: "my-elb"},
+			expectedOK: true,
+		},
+		"VPC endpoint": {
+			networkInterface: awstypes.NetworkInterface{
+				Description:   aws.String("VPC Endpoint Interface vpce-0123456789abcdef0"),
+				InterfaceType: awstypes.NetworkInterfaceTypeVpcEndpoint,
+			},
+			expected:   networkInterfaceOwner{Type: networkInterfaceOwnerTypeVPCEndpoint, ID: "vpce-0123456789abcdef0"},
+			expectedOK: true,
+		},
+		"NAT gateway": {
+			networkInterface: awstypes.NetworkInterface{
+				Description:   aws.String("Interface for NAT Gateway nat-0123456789abcdef0"),
+				InterfaceType: awstypes.NetworkInterfaceTypeNatGateway,
+			},
+			expected:   networkInterfaceOwner{Type: networkInterfaceOwnerTypeNATGateway, ID: "nat-0123456789abcdef0"},
+			expectedOK: true,
+		},
+		"EC2 instance": {
+			networkInterface: awstypes.NetworkInterface{
+				Attachment: &awstypes.NetworkInterfaceAttachment{
+					InstanceId: aws.String("i-0123456789abcdef0"),
+				},
+				InterfaceType: awstypes.NetworkInterfaceTypeInterface,
+			},
+			expected:   networkInterfaceOwner{Type: networkInterfaceOwnerTypeInstance, ID: "i-0123456789abcdef0"},
+			expectedOK: true,
+		},
+		"unattached": {
+			networkInterface: awstypes.NetworkInterface{
+				Description:   aws.String("my interface"),
+				InterfaceType: awstypes.NetworkInterfaceTypeInterface,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := networkInterfaceOwnerOf(&testCase.networkInterface)
+
+			if ok != testCase.expectedOK {
+				t.Fatalf("networkInterfaceOwnerOf() ok = %t, want %t", ok, testCase.expectedOK)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("networkInterfaceOwnerOf() = %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
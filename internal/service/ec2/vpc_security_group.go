@@ -35,6 +35,7 @@ import (
 
 // @SDKResource("aws_security_group", name="Security Group")
 // @Tags(identifierAttribute="id")
+// @ImportFromARN(service="ec2", resource="security-group/{id}", id="{id}")
 func ResourceSecurityGroup() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -410,6 +411,10 @@ func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, me
 		return diags
 	}
 
+	if tfawserr.ErrCodeEquals(err, errCodeDependencyViolation) || tfawserr.ErrCodeEquals(err, errCodeInvalidGroupInUse) {
+		err = dependencyViolationError(ctx, meta.(*conns.AWSClient).EC2Client(ctx), "group-id", d.Id(), err)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting Security Group (%s): %s", d.Id(), err)
 	}
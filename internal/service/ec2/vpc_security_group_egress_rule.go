@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 )
 
@@ -30,7 +31,9 @@ func (*securityGroupEgressRuleResource) Metadata(_ context.Context, request reso
 }
 
 func (*securityGroupEgressRuleResource) MoveState(ctx context.Context) []resource.StateMover {
-	return []resource.StateMover{}
+	return []resource.StateMover{
+		framework.MoveStateFrom("aws_security_group_rule", legacySecurityGroupRuleResourceSchemaV2(ctx), moveStateResourceSecurityGroupRule(securityGroupRuleTypeEgress)),
+	}
 }
 
 func (r *securityGroupEgressRuleResource) create(ctx context.Context, data *securityGroupRuleResourceModel) (string, error) {
@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -59,10 +60,7 @@ func (*securityGroupIngressRuleResource) Metadata(_ context.Context, request res
 
 func (r *securityGroupIngressRuleResource) MoveState(ctx context.Context) []resource.StateMover {
 	return []resource.StateMover{
-		{
-			SourceSchema: legacySecurityGroupRuleResourceSchemaV2(ctx),
-			StateMover:   r.moveStateResourceSecurityGroupRule,
-		},
+		framework.MoveStateFrom("aws_security_group_rule", legacySecurityGroupRuleResourceSchemaV2(ctx), moveStateResourceSecurityGroupRule(securityGroupRuleTypeIngress)),
 	}
 }
 
@@ -100,63 +98,6 @@ func (r *securityGroupIngressRuleResource) findByID(ctx context.Context, id stri
 	return FindSecurityGroupIngressRuleByID(ctx, conn, id)
 }
 
-// moveStateResourceSecurityGroupRule transforms the state of an `aws_security_group_rule` resource to this resource's schema.
-func (r *securityGroupIngressRuleResource) moveStateResourceSecurityGroupRule(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
-	if request.SourceTypeName != "aws_security_group_rule" {
-		return
-	}
-
-	if request.SourceSchemaVersion != 2 {
-		return
-	}
-
-	if !strings.HasSuffix(request.SourceProviderAddress, "hashicorp/aws") {
-		return
-	}
-
-	var source legacySecurityGroupRuleResourceModel
-	response.Diagnostics.Append(request.SourceState.Get(ctx, &source)...)
-	if response.Diagnostics.HasError() {
-		return
-	}
-
-	// TODO: Need to find the security group rule ID.
-
-	// if typ := source.Type.ValueEnum(); typ != securityGroupRuleTypeIngress {
-	// 	response.Diagnostics.AddError("Incorrect Type", string(typ))
-	// 	return
-	// }
-
-	// nCIDRs := 0
-	// if !source.CIDRBlocks.IsNull() {
-	// 	nCIDRs += len(source.CIDRBlocks.Elements())
-	// }
-	// nIPv6CIDRs := 0
-	// if !source.IPv6CIDRBlocksBlocks.IsNull() {
-	// 	nIPv6CIDRs += len(source.IPv6CIDRBlocksBlocks.Elements())
-	// }
-	// nPrefxListIDs := 0
-	// if !source.PrefixListIDs.IsNull() {
-	// 	nPrefxListIDs += len(source.PrefixListIDs.Elements())
-	// }
-	// nSourceSecurityGroupIDs := 0
-	// if !source.SourceSecurityGroupID.IsNull() && source.SourceSecurityGroupID.ValueString() != "" {
-	// 	nSourceSecurityGroupIDs = 1
-	// }
-
-	// if nCIDRs+nIPv6CIDRs+nPrefxListIDs+nSourceSecurityGroupIDs > 1 {
-	// 	response.Diagnostics.AddError("Multiple Sources", "Only one source is allowed")
-	// 	return
-	// }
-
-	// target := &securityGroupRuleResourceModel{
-	// 	// ARN: 				  r.securityGroupRuleARN(ctx, securityGroupRuleID),
-	// 	Description: fwflex.EmptyStringAsNull(source.Description),
-	// }
-
-	// response.Diagnostics.Append(response.TargetState.Set(ctx, target)...)
-}
-
 // Base structure and methods for VPC security group rules.
 
 type securityGroupRule interface {
@@ -725,6 +666,34 @@ func legacySecurityGroupRuleResourceSchemaV2(ctx context.Context) *schema.Schema
 	}
 }
 
+// moveStateResourceSecurityGroupRule returns a function that transforms the state of an `aws_security_group_rule` resource of the specified type
+// to the schema of the corresponding VPC security group rule resource.
+func moveStateResourceSecurityGroupRule(ruleType securityGroupRuleType) func(context.Context, resource.MoveStateRequest, *resource.MoveStateResponse) {
+	return func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+		var source legacySecurityGroupRuleResourceModel
+		response.Diagnostics.Append(request.SourceState.Get(ctx, &source)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if typ := source.Type.ValueEnum(); typ != ruleType {
+			response.Diagnostics.AddError("Incorrect Security Group Rule Type", fmt.Sprintf("An aws_security_group_rule resource of type %q cannot be moved to a resource for %q rules.", typ, ruleType))
+
+			return
+		}
+
+		target, err := source.moveTo(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("moving Security Group Rule (%s)", source.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(response.TargetState.Set(ctx, target)...)
+	}
+}
+
 type legacySecurityGroupRuleResourceModel struct {
 	CIDRBlocks            fwtypes.ListValueOf[types.String]         `tfsdk:"cidr_blocks"`
 	Description           types.String                              `tfsdk:"description"`
@@ -741,3 +710,65 @@ type legacySecurityGroupRuleResourceModel struct {
 	ToPort                types.Int64                               `tfsdk:"to_port"`
 	Type                  fwtypes.StringEnum[securityGroupRuleType] `tfsdk:"type"`
 }
+
+// moveTo returns the VPC security group rule resource model for the legacy security group rule.
+// A legacy security group rule can only be moved if it corresponds to a single VPC security group rule, i.e. it has a single source.
+// The VPC security group rule's ARN is set when its state is next refreshed.
+func (model *legacySecurityGroupRuleResourceModel) moveTo(ctx context.Context) (*securityGroupRuleResourceModel, error) {
+	securityGroupRuleID := model.SecurityGroupRuleID.ValueString()
+
+	if securityGroupRuleID == "" {
+		return nil, errors.New("security group rule ID not found, refresh the aws_security_group_rule resource's state and try again")
+	}
+
+	target := &securityGroupRuleResourceModel{
+		ARN:                       types.StringNull(),
+		CIDRIPv4:                  types.StringNull(),
+		CIDRIPv6:                  types.StringNull(),
+		Description:               fwflex.EmptyStringAsNull(model.Description),
+		FromPort:                  model.FromPort,
+		ID:                        types.StringValue(securityGroupRuleID),
+		IPProtocol:                model.Protocol,
+		PrefixListID:              types.StringNull(),
+		ReferencedSecurityGroupID: types.StringNull(),
+		SecurityGroupID:           model.SecurityGroupID,
+		SecurityGroupRuleID:       types.StringValue(securityGroupRuleID),
+		Tags:                      types.MapNull(types.StringType),
+		TagsAll:                   types.MapNull(types.StringType),
+		ToPort:                    model.ToPort,
+	}
+
+	// Rules for all protocols have no ports.
+	if protocolForValue(model.Protocol.ValueString()) == "-1" {
+		target.FromPort = types.Int64Null()
+		target.ToPort = types.Int64Null()
+	}
+
+	var sources []string
+
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, model.CIDRBlocks) {
+		target.CIDRIPv4 = types.StringValue(v)
+		sources = append(sources, v)
+	}
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, model.IPv6CIDRBlocksBlocks) {
+		target.CIDRIPv6 = types.StringValue(v)
+		sources = append(sources, v)
+	}
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, model.PrefixListIDs) {
+		target.PrefixListID = types.StringValue(v)
+		sources = append(sources, v)
+	}
+	if v := model.SourceSecurityGroupID.ValueString(); v != "" {
+		target.ReferencedSecurityGroupID = types.StringValue(v)
+		sources = append(sources, v)
+	} else if model.Self.ValueBool() {
+		target.ReferencedSecurityGroupID = model.SecurityGroupID
+		sources = append(sources, model.SecurityGroupID.ValueString())
+	}
+
+	if n := len(sources); n != 1 {
+		return nil, fmt.Errorf("rule has %d sources (%s), only rules with a single source can be moved", n, strings.Join(sources, ", "))
+	}
+
+	return target, nil
+}
@@ -7,17 +7,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -63,6 +68,227 @@ func TestIPProtocol(t *testing.T) {
 	}
 }
 
+func TestMoveLegacySecurityGroupRule(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	const (
+		securityGroupID       = "sg-11111111"
+		securityGroupRuleID   = "sgr-11111111"
+		sourceSecurityGroupID = "sg-22222222"
+	)
+	stringList := func(vs ...string) fwtypes.ListValueOf[types.String] {
+		if len(vs) == 0 {
+			return fwtypes.NewListValueOfNull[types.String](ctx)
+		}
+
+		var elements []attr.Value
+		for _, v := range vs {
+			elements = append(elements, types.StringValue(v))
+		}
+
+		return fwtypes.NewListValueOfMust[types.String](ctx, elements)
+	}
+	legacyRule := func(protocol string, optFns ...func(*tfec2.LegacySecurityGroupRuleResourceModel)) *tfec2.LegacySecurityGroupRuleResourceModel {
+		model := &tfec2.LegacySecurityGroupRuleResourceModel{
+			CIDRBlocks:            stringList(),
+			Description:           types.StringValue(""),
+			FromPort:              types.Int64Value(80),
+			ID:                    types.StringValue("sgrule-1234567890"),
+			IPv6CIDRBlocksBlocks:  stringList(),
+			PrefixListIDs:         stringList(),
+			Protocol:              tfec2.IPProtocol{StringValue: types.StringValue(protocol)},
+			SecurityGroupID:       types.StringValue(securityGroupID),
+			SecurityGroupRuleID:   types.StringValue(securityGroupRuleID),
+			Self:                  types.BoolValue(false),
+			SourceSecurityGroupID: types.StringValue(""),
+			ToPort:                types.Int64Value(8080),
+		}
+
+		for _, optFn := range optFns {
+			optFn(model)
+		}
+
+		return model
+	}
+
+	type testCase struct {
+		source                        *tfec2.LegacySecurityGroupRuleResourceModel
+		wantErr                       string
+		wantCIDRIPv4                  types.String
+		wantCIDRIPv6                  types.String
+		wantPrefixListID              types.String
+		wantReferencedSecurityGroupID types.String
+		wantFromPort, wantToPort      types.Int64
+	}
+	tests := map[string]testCase{
+		"IPv4 CIDR block": {
+			source: legacyRule("tcp", func(model *tfec2.LegacySecurityGroupRuleResourceModel) {
+				model.CIDRBlocks = stringList("10.0.0.0/16")
+			}),
+			wantCIDRIPv4:                  types.StringValue("10.0.0.0/16"),
+			wantCIDRIPv6:                  types.StringNull(),
+			wantPrefixListID:              types.StringNull(),
+			wantReferencedSecurityGroupID: types.StringNull(),
+			wantFromPort:                  types.Int64Value(80),
+			wantToPort:                    types.Int64Value(8080),
+		},
+		"IPv6 CIDR block": {
+			source: legacyRule("tcp", func(model *tfec2.LegacySecurityGroupRuleResourceModel) {
+				model.IPv6CIDRBlocksBlocks = stringList("::/0")
+			}),
+			wantCIDRIPv4:                  types.StringNull(),
+			wantCIDRIPv6:                  types.StringValue("::/0"),
+			wantPrefixListID:              types.StringNull(),
+			wantReferencedSecurityGroupID: types.StringNull(),
+			wantFromPort:                  types.Int64Value(80),
+			wantToPort:                    types.Int64Value(8080),
+		},
+		"prefix list": {
+			source: legacyRule("tcp", func(model *tfec2.LegacySecurityGroupRuleResourceModel) {
+				model.PrefixListIDs = stringList("pl-11111111")
+			}),
+			wantCIDRIPv4:                  types.StringNull(),
+			wantCIDRIPv6:                  types.StringNull(),
+			wantPrefixListID:              types.StringValue("pl-11111111"),
+			wantReferencedSecurityGroupID: types.StringNull(),
+			wantFromPort:                  types.Int64Value(80),
+			wantToPort:                    types.Int64Value(8080),
+		},
+		"source security group": {
+			source: legacyRule("tcp", func(model *tfec2.LegacySecurityGroupRuleResourceModel) {
+				model.SourceSecurityGroupID = types.StringValue(sourceSecurityGroupID)
+			}),
+			wantCIDRIPv4:                  types.StringNull(),
+			wantCIDRIPv6:                  types.StringNull(),
+			wantPrefixListID:              types.StringNull(),
+			wantReferencedSecurityGroupID: types.StringValue(sourceSecurityGroupID),
+			wantFromPort:                  types.Int64Value(80),
+			wantToPort:                    types.Int64Value(8080),
+		},
+		"self": {
+			source: legacyRule("tcp", func(model *tfec2.LegacySecurityGroupRuleResourceModel) {
+				model.Self = types.BoolValue(true)
+			}),
+			wantCIDRIPv4:                  types.StringNull(),
+			wantCIDRIPv6:                  types.StringNull(),
+			wantPrefixListID:              types.StringNull(),
+			wantReferencedSecurityGroupID: types.StringValue(securityGroupID),
+			wantFromPort:                  types.Int64Value(80),
+			wantToPort:                    types.Int64Value(8080),
+		},
+		"all protocols": {
+			source: legacyRule("-1", func(model *tfec2.LegacySecurityGroupRuleResourceModel) {
+				model.CIDRBlocks = stringList("10.0.0.0/16")
+				model.FromPort = types.Int64Value(0)
+				model.ToPort = types.Int64Value(0)
+			}),
+			wantCIDRIPv4:                  types.StringValue("10.0.0.0/16"),
+			wantCIDRIPv6:                  types.StringNull(),
+			wantPrefixListID:              types.StringNull(),
+			wantReferencedSecurityGroupID: types.StringNull(),
+			wantFromPort:                  types.Int64Null(),
+			wantToPort:                    types.Int64Null(),
+		},
+		"all protocols by name": {
+			source: legacyRule("all", func(model *tfec2.LegacySecurityGroupRuleResourceModel) {
+				model.CIDRBlocks = stringList("10.0.0.0/16")
+				model.FromPort = types.Int64Value(0)
+				model.ToPort = types.Int64Value(0)
+			}),
+			wantCIDRIPv4:                  types.StringValue("10.0.0.0/16"),
+			wantCIDRIPv6:                  types.StringNull(),
+			wantPrefixListID:              types.StringNull(),
+			wantReferencedSecurityGroupID: types.StringNull(),
+			wantFromPort:                  types.Int64Null(),
+			wantToPort:                    types.Int64Null(),
+		},
+		"no sources": {
+			source:  legacyRule("tcp"),
+			wantErr: "rule has 0 sources",
+		},
+		"multiple CIDR blocks": {
+			source: legacyRule("tcp", func(model *tfec2.LegacySecurityGroupRuleResourceModel) {
+				model.CIDRBlocks = stringList("10.0.0.0/16", "10.1.0.0/16")
+			}),
+			wantErr: "rule has 2 sources (10.0.0.0/16, 10.1.0.0/16)",
+		},
+		"CIDR block and self": {
+			source: legacyRule("tcp", func(model *tfec2.LegacySecurityGroupRuleResourceModel) {
+				model.CIDRBlocks = stringList("10.0.0.0/16")
+				model.Self = types.BoolValue(true)
+			}),
+			wantErr: "rule has 2 sources",
+		},
+		"empty security group rule ID": {
+			source: legacyRule("tcp", func(model *tfec2.LegacySecurityGroupRuleResourceModel) {
+				model.CIDRBlocks = stringList("10.0.0.0/16")
+				model.SecurityGroupRuleID = types.StringValue("")
+			}),
+			wantErr: "security group rule ID not found",
+		},
+		"null security group rule ID": {
+			source: legacyRule("tcp", func(model *tfec2.LegacySecurityGroupRuleResourceModel) {
+				model.CIDRBlocks = stringList("10.0.0.0/16")
+				model.SecurityGroupRuleID = types.StringNull()
+			}),
+			wantErr: "security group rule ID not found",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			target, err := tfec2.MoveLegacySecurityGroupRule(test.source, ctx)
+
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("err = %v, want to contain %q", err, test.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := target.ID, types.StringValue(securityGroupRuleID); !got.Equal(want) {
+				t.Errorf("ID = %s, want %s", got, want)
+			}
+			if got, want := target.SecurityGroupRuleID, types.StringValue(securityGroupRuleID); !got.Equal(want) {
+				t.Errorf("SecurityGroupRuleID = %s, want %s", got, want)
+			}
+			if got, want := target.SecurityGroupID, types.StringValue(securityGroupID); !got.Equal(want) {
+				t.Errorf("SecurityGroupID = %s, want %s", got, want)
+			}
+			if got := target.Description; !got.IsNull() {
+				t.Errorf("Description = %s, want null", got)
+			}
+			if got, want := target.CIDRIPv4, test.wantCIDRIPv4; !got.Equal(want) {
+				t.Errorf("CIDRIPv4 = %s, want %s", got, want)
+			}
+			if got, want := target.CIDRIPv6, test.wantCIDRIPv6; !got.Equal(want) {
+				t.Errorf("CIDRIPv6 = %s, want %s", got, want)
+			}
+			if got, want := target.PrefixListID, test.wantPrefixListID; !got.Equal(want) {
+				t.Errorf("PrefixListID = %s, want %s", got, want)
+			}
+			if got, want := target.ReferencedSecurityGroupID, test.wantReferencedSecurityGroupID; !got.Equal(want) {
+				t.Errorf("ReferencedSecurityGroupID = %s, want %s", got, want)
+			}
+			if got, want := target.FromPort, test.wantFromPort; !got.Equal(want) {
+				t.Errorf("FromPort = %s, want %s", got, want)
+			}
+			if got, want := target.ToPort, test.wantToPort; !got.Equal(want) {
+				t.Errorf("ToPort = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
 func TestAccVPCSecurityGroupIngressRule_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.SecurityGroupRule
@@ -125,6 +351,46 @@ func TestAccVPCSecurityGroupIngressRule_disappears(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroupIngressRule_movedFromSecurityGroupRule(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.SecurityGroupRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupIngressRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfig_movedFromSecurityGroupRuleSource(rName),
+			},
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfig_movedFromSecurityGroupRuleTarget(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupIngressRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "cidr_ipv4", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "moved"),
+					resource.TestCheckResourceAttr(resourceName, "from_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "ip_protocol", "tcp"),
+					resource.TestCheckResourceAttrSet(resourceName, "security_group_rule_id"),
+					resource.TestCheckResourceAttr(resourceName, "to_port", "8080"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCSecurityGroupIngressRule_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.SecurityGroupRule
@@ -1403,3 +1669,37 @@ resource "aws_vpc_security_group_ingress_rule" "test" {
 }
 `, rName, acctest.Region()))
 }
+
+func testAccVPCSecurityGroupIngressRuleConfig_movedFromSecurityGroupRuleSource(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_security_group_rule" "test" {
+  security_group_id = aws_security_group.test.id
+  type              = "ingress"
+
+  cidr_blocks = ["10.0.0.0/8"]
+  description = "moved"
+  from_port   = 80
+  protocol    = "tcp"
+  to_port     = 8080
+}
+`)
+}
+
+func testAccVPCSecurityGroupIngressRuleConfig_movedFromSecurityGroupRuleTarget(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+moved {
+  from = aws_security_group_rule.test
+  to   = aws_vpc_security_group_ingress_rule.test
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  description = "moved"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+}
+`)
+}
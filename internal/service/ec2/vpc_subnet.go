@@ -27,6 +27,7 @@ import (
 
 // @SDKResource("aws_subnet", name="Subnet")
 // @Tags(identifierAttribute="id")
+// @ImportFromARN(service="ec2", resource="subnet/{id}", id="{id}")
 func ResourceSubnet() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -383,6 +384,10 @@ func resourceSubnetDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return diags
 	}
 
+	if tfawserr.ErrCodeEquals(err, errCodeDependencyViolation) {
+		err = dependencyViolationError(ctx, meta.(*conns.AWSClient).EC2Client(ctx), "subnet-id", d.Id(), err)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting EC2 Subnet (%s): %s", d.Id(), err)
 	}
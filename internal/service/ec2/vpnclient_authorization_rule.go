@@ -25,6 +25,11 @@ import (
 )
 
 // @SDKResource("aws_ec2_client_vpn_authorization_rule", name="Client VPN Authorization Rule")
+// @IDHelpers(parse="ClientVPNAuthorizationRuleParseResourceID", create="ClientVPNAuthorizationRuleCreateResourceID")
+// @IDExample("cvpn-endpoint-0123456789abcdef0,10.0.0.0/16")
+// @IDExample("cvpn-endpoint-0123456789abcdef0,10.0.0.0/16,my-group")
+// @IDExample("cvpn-endpoint-0123456789abcdef0", valid=false)
+// @IDExample("cvpn-endpoint-0123456789abcdef0,,my-group", valid=false)
 func resourceClientVPNAuthorizationRule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClientVPNAuthorizationRuleCreate,
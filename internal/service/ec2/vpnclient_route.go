@@ -24,6 +24,9 @@ import (
 )
 
 // @SDKResource("aws_ec2_client_vpn_route", name="Client VPN Route")
+// @IDHelpers(parse="ClientVPNRouteParseResourceID", create="ClientVPNRouteCreateResourceID")
+// @IDExample("cvpn-endpoint-0123456789abcdef0,subnet-0123456789abcdef0,10.0.0.0/16")
+// @IDExample("cvpn-endpoint-0123456789abcdef0,subnet-0123456789abcdef0", valid=false)
 func resourceClientVPNRoute() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClientVPNRouteCreate,
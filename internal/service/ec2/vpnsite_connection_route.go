@@ -20,6 +20,10 @@ import (
 )
 
 // @SDKResource("aws_vpn_connection_route", name="VPN Connection Route")
+// @IDHelpers(parse="VPNConnectionRouteParseResourceID", create="VPNConnectionRouteCreateResourceID")
+// @IDExample("10.0.0.0/16:vpn-0123456789abcdef0")
+// @IDExample("10.0.0.0/16", valid=false)
+// @IDExample("2001:db8::/32:vpn-0123456789abcdef0", valid=false)
 func resourceVPNConnectionRoute() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPNConnectionRouteCreate,
@@ -17,6 +17,9 @@ import (
 )
 
 // @SDKResource("aws_vpn_gateway_route_propagation", name="VPN Gateway Route Propagation")
+// @IDHelpers(parse="VPNGatewayRoutePropagationParseID", create="VPNGatewayRoutePropagationCreateID")
+// @IDExample("vgw-0123456789abcdef0_rtb-0123456789abcdef0")
+// @IDExample("vgw-0123456789abcdef0", valid=false)
 func resourceVPNGatewayRoutePropagation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPNGatewayRoutePropagationEnable,
@@ -24,7 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// @SDKResource("aws_ec2_carrier_gateway, name="Carrier Gateway")
+// @SDKResource("aws_ec2_carrier_gateway", name="Carrier Gateway")
 // @Tags(identifierAttribute="id")
 func resourceCarrierGateway() *schema.Resource {
 	return &schema.Resource{
@@ -14,6 +14,13 @@ import (
 
 type servicePackage struct{}
 
+var (
+	resourceRepositoryIdentity = &types.ServicePackageResourceIdentity{
+		IDAttributes: []string{"name"},
+		ARNFormat:    "arn:{partition}:ecr:{region}:{account}:repository/{name}",
+	}
+)
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
 		{
@@ -90,6 +97,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: resourceRepositoryIdentity,
 		},
 		{
 			Factory:  resourceRepositoryPolicy,
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_ecrpublic_authorization_token")
+// @Region(global=true)
 func DataSourceAuthorizationToken() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceAuthorizationTokenRead,
@@ -28,6 +28,7 @@ import (
 )
 
 // @SDKResource("aws_ecrpublic_repository", name="Repository")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceRepository() *schema.Resource {
 	return &schema.Resource{
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_ecrpublic_repository_policy")
+// @Region(global=true)
 func ResourceRepositoryPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRepositoryPolicyPut,
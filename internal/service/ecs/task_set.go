@@ -28,6 +28,10 @@ import (
 
 // @SDKResource("aws_ecs_task_set", name="Task Set")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="TaskSetParseID")
+// @IDExample("ecs-svc/1234567890123456789,my-service,my-cluster")
+// @IDExample("ecs-svc/1234567890123456789,my-service", valid=false)
+// @IDExample("ecs-svc/1234567890123456789,,my-cluster", valid=false)
 func ResourceTaskSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTaskSetCreate,
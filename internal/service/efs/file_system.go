@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_efs_file_system", name="File System")
+// @Stateful
 // @Tags(identifierAttribute="id")
 func ResourceFileSystem() *schema.Resource {
 	return &schema.Resource{
@@ -29,6 +29,10 @@ import (
 
 // @SDKResource("aws_eks_access_entry", name="Access Entry")
 // @Tags(identifierAttribute="access_entry_arn")
+// @IDHelpers(parse="accessEntryParseResourceID", create="accessEntryCreateResourceID")
+// @IDExample("my-cluster:arn:aws:iam::123456789012:role/my-role")
+// @IDExample("my-cluster", valid=false)
+// @IDExample(":arn:aws:iam::123456789012:role/my-role", valid=false)
 func resourceAccessEntry() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccessEntryCreate,
@@ -27,6 +27,9 @@ import (
 )
 
 // @SDKResource("aws_eks_access_policy_association", name="Access Policy Association")
+// @IDHelpers(parse="accessPolicyAssociationParseResourceID", create="accessPolicyAssociationCreateResourceID")
+// @IDExample("my-cluster#arn:aws:iam::123456789012:role/my-role#arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy")
+// @IDExample("my-cluster#arn:aws:iam::123456789012:role/my-role", valid=false)
 func resourceAccessPolicyAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccessPolicyAssociationCreate,
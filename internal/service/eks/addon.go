@@ -32,6 +32,10 @@ import (
 
 // @SDKResource("aws_eks_addon", name="Add-On")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="AddonParseResourceID", create="AddonCreateResourceID")
+// @IDExample("my-cluster:vpc-cni")
+// @IDExample("my-cluster", valid=false)
+// @IDExample("my-cluster:vpc-cni:extra", valid=false)
 func resourceAddon() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAddonCreate,
@@ -30,6 +30,10 @@ import (
 
 // @SDKResource("aws_eks_fargate_profile", name="Fargate Profile")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="FargateProfileParseResourceID", create="FargateProfileCreateResourceID")
+// @IDExample("my-cluster:my-profile")
+// @IDExample("my-cluster", valid=false)
+// @IDExample("my-cluster:my-profile:extra", valid=false)
 func resourceFargateProfile() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFargateProfileCreate,
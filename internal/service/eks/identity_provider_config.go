@@ -29,6 +29,10 @@ import (
 
 // @SDKResource("aws_eks_identity_provider_config", name="Identity Provider Config")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="IdentityProviderConfigParseResourceID", create="IdentityProviderConfigCreateResourceID")
+// @IDExample("my-cluster:my-config")
+// @IDExample("my-cluster", valid=false)
+// @IDExample("my-cluster:my-config:extra", valid=false)
 func resourceIdentityProviderConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceIdentityProviderConfigCreate,
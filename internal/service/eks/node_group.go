@@ -34,6 +34,10 @@ import (
 
 // @SDKResource("aws_eks_node_group", name="Node Group")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="NodeGroupParseResourceID", create="NodeGroupCreateResourceID")
+// @IDExample("my-cluster:my-node-group")
+// @IDExample("my-cluster", valid=false)
+// @IDExample("my-cluster:my-node-group:extra", valid=false)
 func resourceNodeGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNodeGroupCreate,
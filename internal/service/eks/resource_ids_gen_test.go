@@ -0,0 +1,234 @@
+// Code generated by internal/generate/idtests/main.go; DO NOT EDIT.
+
+package eks
+
+import (
+	"testing"
+)
+
+func TestAccessEntryResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-cluster:arn:aws:iam::123456789012:role/my-role": true, //lintignore:AWSAT005
+		"my-cluster": false,
+		":arn:aws:iam::123456789012:role/my-role": false, //lintignore:AWSAT005
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := accessEntryParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("accessEntryParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("accessEntryParseResourceID(%q): %s", id, err)
+			}
+
+			got := accessEntryCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("accessEntryCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccessPolicyAssociationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-cluster#arn:aws:iam::123456789012:role/my-role#arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy": true,  //lintignore:AWSAT005
+		"my-cluster#arn:aws:iam::123456789012:role/my-role":                                                            false, //lintignore:AWSAT005
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := accessPolicyAssociationParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("accessPolicyAssociationParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("accessPolicyAssociationParseResourceID(%q): %s", id, err)
+			}
+
+			got := accessPolicyAssociationCreateResourceID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("accessPolicyAssociationCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAddOnResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-cluster:vpc-cni":       true,
+		"my-cluster":               false,
+		"my-cluster:vpc-cni:extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := AddonParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("AddonParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("AddonParseResourceID(%q): %s", id, err)
+			}
+
+			got := AddonCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("AddonCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFargateProfileResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-cluster:my-profile":       true,
+		"my-cluster":                  false,
+		"my-cluster:my-profile:extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := FargateProfileParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("FargateProfileParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("FargateProfileParseResourceID(%q): %s", id, err)
+			}
+
+			got := FargateProfileCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("FargateProfileCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestIdentityProviderConfigResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-cluster:my-config":       true,
+		"my-cluster":                 false,
+		"my-cluster:my-config:extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := IdentityProviderConfigParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("IdentityProviderConfigParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("IdentityProviderConfigParseResourceID(%q): %s", id, err)
+			}
+
+			got := IdentityProviderConfigCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("IdentityProviderConfigCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestNodeGroupResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-cluster:my-node-group":       true,
+		"my-cluster":                     false,
+		"my-cluster:my-node-group:extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := NodeGroupParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("NodeGroupParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("NodeGroupParseResourceID(%q): %s", id, err)
+			}
+
+			got := NodeGroupCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("NodeGroupCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -17,6 +17,13 @@ import (
 
 type servicePackage struct{}
 
+var (
+	resourceUserGroupAssociationIdentity = &types.ServicePackageResourceIdentity{
+		IDAttributes: []string{"user_group_id", "user_id"},
+		IDSeparator:  ",",
+	}
+)
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{}
 }
@@ -117,6 +124,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceUserGroupAssociation,
 			TypeName: "aws_elasticache_user_group_association",
 			Name:     "User Group Association",
+			Identity: resourceUserGroupAssociationIdentity,
 		},
 	}
 }
@@ -17,25 +17,18 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-const (
-	userGroupAssociationResourceIDPartCount = 2
-)
-
 // @SDKResource("aws_elasticache_user_group_association", name="User Group Association")
+// @Identity(attributes="user_group_id;user_id")
 func resourceUserGroupAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserGroupAssociationCreate,
 		ReadWithoutTimeout:   resourceUserGroupAssociationRead,
 		DeleteWithoutTimeout: resourceUserGroupAssociationDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			"user_group_id": {
 				Type:     schema.TypeString,
@@ -57,7 +50,7 @@ func resourceUserGroupAssociationCreate(ctx context.Context, d *schema.ResourceD
 
 	userGroupID := d.Get("user_group_id").(string)
 	userID := d.Get("user_id").(string)
-	id := errs.Must(flex.FlattenResourceId([]string{userGroupID, userID}, userGroupAssociationResourceIDPartCount, true))
+	id := errs.Must(identity.FormatID(resourceUserGroupAssociationIdentity, userGroupID, userID))
 	input := &elasticache.ModifyUserGroupInput{
 		UserGroupId:  aws.String(userGroupID),
 		UserIdsToAdd: aws.StringSlice([]string{userID}),
@@ -84,7 +77,7 @@ func resourceUserGroupAssociationRead(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
 
-	parts, err := flex.ExpandResourceId(d.Id(), userGroupAssociationResourceIDPartCount, true)
+	parts, err := identity.ParseID(resourceUserGroupAssociationIdentity, d.Id())
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
@@ -112,7 +105,7 @@ func resourceUserGroupAssociationDelete(ctx context.Context, d *schema.ResourceD
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
 
-	parts, err := flex.ExpandResourceId(d.Id(), userGroupAssociationResourceIDPartCount, true)
+	parts, err := identity.ParseID(resourceUserGroupAssociationIdentity, d.Id())
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
@@ -24,6 +24,10 @@ import (
 )
 
 // @SDKResource("aws_app_cookie_stickiness_policy")
+// @IDHelpers(parse="AppCookieStickinessPolicyParseResourceID", create="AppCookieStickinessPolicyCreateResourceID")
+// @IDExample("my-elb:80:my-policy")
+// @IDExample("my-elb:http:my-policy", valid=false)
+// @IDExample("my-elb:80", valid=false)
 func ResourceAppCookieStickinessPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAppCookieStickinessPolicyCreate,
@@ -21,6 +21,10 @@ import (
 )
 
 // @SDKResource("aws_load_balancer_backend_server_policy")
+// @IDHelpers(parse="BackendServerPolicyParseResourceID", create="BackendServerPolicyCreateResourceID")
+// @IDExample("my-elb:443")
+// @IDExample("my-elb:https", valid=false)
+// @IDExample("my-elb", valid=false)
 func ResourceBackendServerPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBackendServerPolicySet,
@@ -22,6 +22,10 @@ import (
 )
 
 // @SDKResource("aws_lb_cookie_stickiness_policy")
+// @IDHelpers(parse="LBCookieStickinessPolicyParseResourceID", create="LBCookieStickinessPolicyCreateResourceID")
+// @IDExample("my-elb:80:my-policy")
+// @IDExample("my-elb:80", valid=false)
+// @IDExample("my-elb:http:my-policy", valid=false)
 func ResourceCookieStickinessPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCookieStickinessPolicyCreate,
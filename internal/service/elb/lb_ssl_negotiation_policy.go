@@ -21,6 +21,10 @@ import (
 )
 
 // @SDKResource("aws_lb_ssl_negotiation_policy")
+// @IDHelpers(parse="SSLNegotiationPolicyParseResourceID", create="SSLNegotiationPolicyCreateResourceID")
+// @IDExample("my-elb:443:my-policy")
+// @IDExample("my-elb:https:my-policy", valid=false)
+// @IDExample("my-elb:443", valid=false)
 func ResourceSSLNegotiationPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSSLNegotiationPolicyCreate,
@@ -22,6 +22,10 @@ import (
 )
 
 // @SDKResource("aws_load_balancer_listener_policy")
+// @IDHelpers(parse="ListenerPolicyParseResourceID", create="ListenerPolicyCreateResourceID")
+// @IDExample("my-elb:443")
+// @IDExample("my-elb", valid=false)
+// @IDExample("my-elb:https", valid=false)
 func ResourceListenerPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceListenerPolicySet,
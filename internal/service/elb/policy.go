@@ -21,6 +21,9 @@ import (
 )
 
 // @SDKResource("aws_load_balancer_policy")
+// @IDHelpers(parse="PolicyParseResourceID", create="PolicyCreateResourceID")
+// @IDExample("my-elb:my-policy")
+// @IDExample("my-elb", valid=false)
 func ResourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePolicyCreate,
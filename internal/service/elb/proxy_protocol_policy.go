@@ -20,6 +20,8 @@ import (
 )
 
 // @SDKResource("aws_proxy_protocol_policy")
+// @IDHelpers(parse="resourceProxyProtocolPolicyParseID")
+// @IDExample("my-elb:TFEnableProxyProtocol")
 func ResourceProxyProtocolPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProxyProtocolPolicyCreate,
@@ -0,0 +1,252 @@
+// Code generated by internal/generate/idtests/main.go; DO NOT EDIT.
+
+package elb
+
+import (
+	"testing"
+)
+
+func TestAppCookieStickinessPolicyResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-elb:80:my-policy":   true,
+		"my-elb:http:my-policy": false,
+		"my-elb:80":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := AppCookieStickinessPolicyParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("AppCookieStickinessPolicyParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("AppCookieStickinessPolicyParseResourceID(%q): %s", id, err)
+			}
+
+			got := AppCookieStickinessPolicyCreateResourceID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("AppCookieStickinessPolicyCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBackendServerPolicyResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-elb:443":   true,
+		"my-elb:https": false,
+		"my-elb":       false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := BackendServerPolicyParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("BackendServerPolicyParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("BackendServerPolicyParseResourceID(%q): %s", id, err)
+			}
+
+			got := BackendServerPolicyCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("BackendServerPolicyCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestCookieStickinessPolicyResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-elb:80:my-policy":   true,
+		"my-elb:80":             false,
+		"my-elb:http:my-policy": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := LBCookieStickinessPolicyParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("LBCookieStickinessPolicyParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("LBCookieStickinessPolicyParseResourceID(%q): %s", id, err)
+			}
+
+			got := LBCookieStickinessPolicyCreateResourceID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("LBCookieStickinessPolicyCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestListenerPolicyResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-elb:443":   true,
+		"my-elb":       false,
+		"my-elb:https": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ListenerPolicyParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ListenerPolicyParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ListenerPolicyParseResourceID(%q): %s", id, err)
+			}
+
+			got := ListenerPolicyCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("ListenerPolicyCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestPolicyResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-elb:my-policy": true,
+		"my-elb":           false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := PolicyParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("PolicyParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("PolicyParseResourceID(%q): %s", id, err)
+			}
+
+			got := PolicyCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("PolicyCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestProxyProtocolPolicyResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-elb:TFEnableProxyProtocol": true,
+	}
+
+	for id := range testCases {
+		id := id
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_ = resourceProxyProtocolPolicyParseID(id)
+		})
+	}
+}
+
+func TestSSLNegotiationPolicyResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-elb:443:my-policy":   true,
+		"my-elb:https:my-policy": false,
+		"my-elb:443":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := SSLNegotiationPolicyParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("SSLNegotiationPolicyParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("SSLNegotiationPolicyParseResourceID(%q): %s", id, err)
+			}
+
+			got := SSLNegotiationPolicyCreateResourceID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("SSLNegotiationPolicyCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
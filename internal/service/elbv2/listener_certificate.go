@@ -25,6 +25,9 @@ import (
 
 // @SDKResource("aws_alb_listener_certificate")
 // @SDKResource("aws_lb_listener_certificate")
+// @IDHelpers(parse="listenerCertificateParseID", create="listenerCertificateCreateID")
+// @IDExample("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2_arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012")
+// @IDExample("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2", valid=false)
 func ResourceListenerCertificate() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceListenerCertificateCreate,
@@ -27,6 +27,11 @@ import (
 )
 
 // @SDKResource("aws_cloudwatch_event_permission", name="Permission")
+// @IDHelpers(parse="permissionParseResourceID", create="permissionCreateResourceID")
+// @IDExample("my-statement")
+// @IDExample("my-bus/my-statement")
+// @IDExample("my-bus/my-statement/extra", valid=false)
+// @IDExample("/my-statement", valid=false)
 func resourcePermission() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePermissionCreate,
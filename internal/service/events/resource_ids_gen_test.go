@@ -0,0 +1,122 @@
+// Code generated by internal/generate/idtests/main.go; DO NOT EDIT.
+
+package events
+
+import (
+	"testing"
+)
+
+func TestPermissionResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-statement":              true,
+		"my-bus/my-statement":       true,
+		"my-bus/my-statement/extra": false,
+		"/my-statement":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := permissionParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("permissionParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("permissionParseResourceID(%q): %s", id, err)
+			}
+
+			got := permissionCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("permissionCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestRuleResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-rule":                             true,
+		"my-bus/my-rule":                      true,
+		"aws.partner/example.com/123/my-rule": true,
+		"/my-rule":                            false,
+		"my-bus/":                             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ruleParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ruleParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ruleParseResourceID(%q): %s", id, err)
+			}
+
+			got := ruleCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("ruleCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTargetResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-rule/my-target":        true,
+		"my-bus/my-rule/my-target": true,
+		"arn:aws:events:us-west-2:123456789012:event-bus/my-bus/my-rule/my-target": true, //lintignore:AWSAT003,AWSAT005
+		"aws.partner/example.com/123/my-rule/my-target":                            true,
+		"my-rule":                        false,
+		"my-bus/my-rule/":                false,
+		"my-bus/extra/my-rule/my-target": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, _, err := targetParseImportID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("targetParseImportID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("targetParseImportID(%q): %s", id, err)
+			}
+		})
+	}
+}
@@ -33,6 +33,12 @@ import (
 
 // @SDKResource("aws_cloudwatch_event_rule", name="Rule")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ruleParseResourceID", create="ruleCreateResourceID")
+// @IDExample("my-rule")
+// @IDExample("my-bus/my-rule")
+// @IDExample("aws.partner/example.com/123/my-rule")
+// @IDExample("/my-rule", valid=false)
+// @IDExample("my-bus/", valid=false)
 func resourceRule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRuleCreate,
@@ -34,6 +34,14 @@ import (
 )
 
 // @SDKResource("aws_cloudwatch_event_target", name="Target")
+// @IDHelpers(parse="targetParseImportID")
+// @IDExample("my-rule/my-target")
+// @IDExample("my-bus/my-rule/my-target")
+// @IDExample("arn:aws:events:us-west-2:123456789012:event-bus/my-bus/my-rule/my-target")
+// @IDExample("aws.partner/example.com/123/my-rule/my-target")
+// @IDExample("my-rule", valid=false)
+// @IDExample("my-bus/my-rule/", valid=false)
+// @IDExample("my-bus/extra/my-rule/my-target", valid=false)
 func resourceTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTargetCreate,
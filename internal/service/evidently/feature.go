@@ -32,6 +32,11 @@ import (
 
 // @SDKResource("aws_evidently_feature", name="Feature")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="FeatureParseID")
+// @IDExample("my-feature:my-project")
+// @IDExample("my-feature:arn:aws:evidently:us-west-2:123456789012:project/my-project")
+// @IDExample("my-feature", valid=false)
+// @IDExample(":my-project", valid=false)
 func ResourceFeature() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFeatureCreate,
@@ -30,6 +30,11 @@ import (
 
 // @SDKResource("aws_evidently_launch", name="Launch")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="LaunchParseID")
+// @IDExample("my-launch:my-project")
+// @IDExample("my-launch:arn:aws:evidently:us-west-2:123456789012:project/my-project")
+// @IDExample("my-launch", valid=false)
+// @IDExample(":my-project", valid=false)
 func ResourceLaunch() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLaunchCreate,
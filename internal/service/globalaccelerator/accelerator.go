@@ -29,6 +29,7 @@ import (
 )
 
 // @SDKResource("aws_globalaccelerator_accelerator", name="Accelerator")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func resourceAccelerator() *schema.Resource {
 	return &schema.Resource{
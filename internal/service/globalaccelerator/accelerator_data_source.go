@@ -21,6 +21,7 @@ import (
 )
 
 // @FrameworkDataSource(name="Accelerator")
+// @Region(global=true)
 func newAcceleratorDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &acceleratorDataSource{}
 
@@ -31,6 +31,7 @@ import (
 )
 
 // @FrameworkResource(name="Cross-account Attachment")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func newCrossAccountAttachmentResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &crossAccountAttachmentResource{}
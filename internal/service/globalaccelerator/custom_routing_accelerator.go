@@ -29,6 +29,7 @@ import (
 )
 
 // @SDKResource("aws_globalaccelerator_custom_routing_accelerator", name="Custom Routing Accelerator")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func resourceCustomRoutingAccelerator() *schema.Resource {
 	return &schema.Resource{
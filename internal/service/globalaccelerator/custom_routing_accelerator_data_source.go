@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_globalaccelerator_custom_routing_accelerator", name="Custom Routing Accelerator")
+// @Region(global=true)
 func dataSourceCustomRoutingAccelerator() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceCustomRoutingAcceleratorRead,
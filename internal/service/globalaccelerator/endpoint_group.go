@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_globalaccelerator_endpoint_group", name="Endpoint Group")
+// @Region(global=true)
 func resourceEndpointGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEndpointGroupCreate,
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_globalaccelerator_listener", name="Listener")
+// @Region(global=true)
 func resourceListener() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceListenerCreate,
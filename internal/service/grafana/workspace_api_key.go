@@ -21,6 +21,9 @@ import (
 )
 
 // @SDKResource("aws_grafana_workspace_api_key")
+// @IDHelpers(parse="WorkspaceAPIKeyParseResourceID", create="WorkspaceAPIKeyCreateResourceID")
+// @IDExample("g-0123456789/my-key")
+// @IDExample("g-0123456789", valid=false)
 func ResourceWorkspaceAPIKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceWorkspaceAPIKeyCreate,
@@ -22,6 +22,9 @@ import (
 )
 
 // @SDKResource("aws_guardduty_detector_feature", name="Detector Feature")
+// @IDHelpers(parse="detectorFeatureParseResourceID", create="detectorFeatureCreateResourceID")
+// @IDExample("12abc34d567e8fa901bc2d34e56789f0/S3_DATA_EVENTS")
+// @IDExample("12abc34d567e8fa901bc2d34e56789f0", valid=false)
 func ResourceDetectorFeature() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDetectorFeaturePut,
@@ -28,6 +28,9 @@ import (
 
 // @SDKResource("aws_guardduty_filter", name="Filter")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="FilterParseID", create="filterCreateID")
+// @IDExample("12abc34d567e8fa901bc2d34e56789f0:my-filter")
+// @IDExample("12abc34d567e8fa901bc2d34e56789f0", valid=false)
 func ResourceFilter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFilterCreate,
@@ -22,6 +22,9 @@ import (
 )
 
 // @SDKResource("aws_guardduty_organization_configuration_feature", name="Organization Configuration Feature")
+// @IDHelpers(parse="organizationConfigurationFeatureParseResourceID", create="organizationConfigurationFeatureCreateResourceID")
+// @IDExample("12abc34d567e8fa901bc2d34e56789f0/S3_DATA_EVENTS")
+// @IDExample("12abc34d567e8fa901bc2d34e56789f0", valid=false)
 func ResourceOrganizationConfigurationFeature() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOrganizationConfigurationFeaturePut,
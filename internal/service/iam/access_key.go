@@ -29,6 +29,7 @@ import (
 )
 
 // @SDKResource("aws_iam_access_key", name="Access Key")
+// @Region(global=true)
 func resourceAccessKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccessKeyCreate,
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_access_keys", name="Access Keys")
+// @Region(global=true)
 func dataSourceAccessKeys() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceAccessKeysRead,
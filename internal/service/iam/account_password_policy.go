@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKResource("aws_iam_account_password_policy", name="Account Password Policy")
+// @Region(global=true)
 func resourceAccountPasswordPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccountPasswordPolicyUpdate,
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_group", name="Group")
+// @Region(global=true)
 func dataSourceGroup() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceGroupRead,
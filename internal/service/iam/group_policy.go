@@ -26,6 +26,11 @@ import (
 )
 
 // @SDKResource("aws_iam_group_policy", name="Group Policy")
+// @Region(global=true)
+// @IDHelpers(parse="GroupPolicyParseID")
+// @IDExample("my-group:my-policy")
+// @IDExample("my-group", valid=false)
+// @IDExample(":my-policy", valid=false)
 func resourceGroupPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupPolicyPut,
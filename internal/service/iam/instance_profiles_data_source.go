@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_instance_profiles", name="Instance Profiles")
+// @Region(global=true)
 func dataSourceInstanceProfiles() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceInstanceProfilesRead,
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_iam_openid_connect_provider", name="OIDC Provider")
+// @Region(global=true)
 // @Tags(identifierAttribute="id", resourceType="OIDCProvider")
 // @Testing(name="OpenIDConnectProvider")
 func resourceOpenIDConnectProvider() *schema.Resource {
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_openid_connect_provider", name="OIDC Provider")
+// @Region(global=true)
 func dataSourceOpenIDConnectProvider() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOpenIDConnectProviderRead,
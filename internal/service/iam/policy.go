@@ -35,7 +35,9 @@ const (
 )
 
 // @SDKResource("aws_iam_policy", name="Policy")
+// @Region(global=true)
 // @Tags(identifierAttribute="id", resourceType="Policy")
+// @ImportFromARN(service="iam", resource="policy/*{name}")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Policy")
 func resourcePolicy() *schema.Resource {
 	return &schema.Resource{
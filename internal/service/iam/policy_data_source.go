@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_policy", name="Policy")
+// @Region(global=true)
 func dataSourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePolicyRead,
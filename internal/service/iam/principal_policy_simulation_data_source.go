@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_principal_policy_simulation", name="Principal Policy Simulation")
+// @Region(global=true)
 func dataSourcePrincipalPolicySimulation() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePrincipalPolicySimulationRead,
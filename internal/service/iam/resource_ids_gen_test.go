@@ -0,0 +1,133 @@
+// Code generated by internal/generate/idtests/main.go; DO NOT EDIT.
+
+package iam
+
+import (
+	"testing"
+)
+
+func TestGroupPolicyResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-group:my-policy": true,
+		"my-group":           false,
+		":my-policy":         false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := GroupPolicyParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("GroupPolicyParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("GroupPolicyParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestRolePolicyResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-role:my-policy": true,
+		"my-role":           false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := RolePolicyParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("RolePolicyParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("RolePolicyParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestUserPolicyResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-user:my-policy": true,
+		"my-user":           false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := UserPolicyParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("UserPolicyParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("UserPolicyParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestUserPolicyAttachmentResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-user/arn:aws:iam::aws:policy/ReadOnlyAccess": true, //lintignore:AWSAT005
+		"my-user": false,
+		"/arn:aws:iam::aws:policy/ReadOnlyAccess": false, //lintignore:AWSAT005
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := userPolicyAttachmentParseImportID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("userPolicyAttachmentParseImportID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("userPolicyAttachmentParseImportID(%q): %s", id, err)
+			}
+		})
+	}
+}
@@ -40,7 +40,9 @@ const (
 )
 
 // @SDKResource("aws_iam_role", name="Role")
+// @Region(global=true)
 // @Tags(identifierAttribute="id", resourceType="Role")
+// @ImportFromARN(service="iam", resource="role/*{name}", id="{name}")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Role")
 func resourceRole() *schema.Resource {
 	return &schema.Resource{
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_role", name="Role")
+// @Region(global=true)
 func dataSourceRole() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceRoleRead,
@@ -32,6 +32,10 @@ const (
 )
 
 // @SDKResource("aws_iam_role_policy", name="Role Policy")
+// @Region(global=true)
+// @IDHelpers(parse="RolePolicyParseID")
+// @IDExample("my-role:my-policy")
+// @IDExample("my-role", valid=false)
 func resourceRolePolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRolePolicyPut,
@@ -28,6 +28,7 @@ import (
 )
 
 // @SDKResource("aws_iam_saml_provider", name="SAML Provider")
+// @Region(global=true)
 // @Tags(identifierAttribute="id", resourceType="SAMLProvider")
 // @Testing(tagsTest=false)
 func resourceSAMLProvider() *schema.Resource {
@@ -31,6 +31,7 @@ import (
 )
 
 // @SDKResource("aws_iam_service_linked_role", name="Service Linked Role")
+// @Region(global=true)
 // @Tags(identifierAttribute="id", resourceType="ServiceLinkedRole")
 func resourceServiceLinkedRole() *schema.Resource {
 	return &schema.Resource{
@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_iam_service_specific_credential", name="Service Specific Credential")
+// @Region(global=true)
 func resourceServiceSpecificCredential() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceServiceSpecificCredentialCreate,
@@ -28,7 +28,9 @@ import (
 )
 
 // @SDKResource("aws_iam_user", name="User")
+// @Region(global=true)
 // @Tags(identifierAttribute="id", resourceType="User")
+// @ImportFromARN(service="iam", resource="user/*{name}", id="{name}")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.User", importIgnore="force_destroy")
 func resourceUser() *schema.Resource {
 	return &schema.Resource{
@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_user", name="User")
+// @Region(global=true)
 func dataSourceUser() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceUserRead,
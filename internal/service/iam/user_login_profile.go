@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_iam_user_login_profile", name="User Login Profile")
+// @Region(global=true)
 func resourceUserLoginProfile() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserLoginProfileCreate,
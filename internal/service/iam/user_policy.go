@@ -26,6 +26,10 @@ import (
 )
 
 // @SDKResource("aws_iam_user_policy", name="User Policy")
+// @Region(global=true)
+// @IDHelpers(parse="UserPolicyParseID")
+// @IDExample("my-user:my-policy")
+// @IDExample("my-user", valid=false)
 func resourceUserPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserPolicyPut,
@@ -25,6 +25,11 @@ import (
 )
 
 // @SDKResource("aws_iam_user_policy_attachment", name="User Policy Attachment")
+// @Region(global=true)
+// @IDHelpers(parse="userPolicyAttachmentParseImportID")
+// @IDExample("my-user/arn:aws:iam::aws:policy/ReadOnlyAccess")
+// @IDExample("my-user", valid=false)
+// @IDExample("/arn:aws:iam::aws:policy/ReadOnlyAccess", valid=false)
 func resourceUserPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserPolicyAttachmentCreate,
@@ -106,14 +111,11 @@ func resourceUserPolicyAttachmentDelete(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceUserPolicyAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.SplitN(d.Id(), "/", 2)
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("unexpected format of ID (%q), expected <user-name>/<policy_arn>", d.Id())
+	userName, policyARN, err := userPolicyAttachmentParseImportID(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
-	userName := idParts[0]
-	policyARN := idParts[1]
-
 	d.Set("user", userName)
 	d.Set("policy_arn", policyARN)
 	d.SetId(fmt.Sprintf("%s-%s", userName, policyARN))
@@ -121,6 +123,15 @@ func resourceUserPolicyAttachmentImport(ctx context.Context, d *schema.ResourceD
 	return []*schema.ResourceData{d}, nil
 }
 
+func userPolicyAttachmentParseImportID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected <user-name>/<policy_arn>", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
 func attachPolicyToUser(ctx context.Context, conn *iam.Client, user, policyARN string) error {
 	_, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, propagationTimeout, func() (interface{}, error) {
 		return conn.AttachUserPolicy(ctx, &iam.AttachUserPolicyInput{
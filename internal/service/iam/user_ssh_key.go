@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_iam_user_ssh_key", name="User SSH Key")
+// @Region(global=true)
 func resourceUserSSHKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserSSHKeyCreate,
@@ -16,6 +16,7 @@ import (
 )
 
 // @SDKDataSource("aws_iam_users", name="Users")
+// @Region(global=true)
 func dataSourceUsers() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceUsersRead,
@@ -29,6 +29,7 @@ import (
 )
 
 // @SDKResource("aws_iam_virtual_mfa_device", name="Virtual MFA Device")
+// @Region(global=true)
 // @Tags(identifierAttribute="id", resourceType="VirtualMFADevice")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.VirtualMFADevice", importIgnore="base_32_string_seed;qr_code_png")
 func resourceVirtualMFADevice() *schema.Resource {
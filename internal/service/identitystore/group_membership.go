@@ -28,6 +28,10 @@ const (
 )
 
 // @SDKResource("aws_identitystore_group_membership")
+// @IDHelpers(parse="resourceGroupMembershipParseID")
+// @IDExample("d-1234567890/f81d4fae-7dec-11d0-a765-00a0c91e6bf6")
+// @IDExample("d-1234567890", valid=false)
+// @IDExample("/f81d4fae-7dec-11d0-a765-00a0c91e6bf6", valid=false)
 func ResourceGroupMembership() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupMembershipCreate,
@@ -35,6 +35,11 @@ import (
 )
 
 // @SDKResource("aws_inspector2_enabler")
+// @IDHelpers(parse="parseEnablerID")
+// @IDExample("123456789012-EC2")
+// @IDExample("123456789012:210987654321-EC2:ECR")
+// @IDExample("123456789012", valid=false)
+// @IDExample("123456789012-EC2-ECR", valid=false)
 func ResourceEnabler() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEnablerCreate,
@@ -23,6 +23,9 @@ import (
 )
 
 // @SDKResource("aws_iot_policy_attachment")
+// @IDHelpers(parse="policyAttachmentParseResourceID", create="policyAttachmentCreateResourceID")
+// @IDExample("my-policy|arn:aws:iot:us-west-2:123456789012:cert/abc123")
+// @IDExample("my-policy", valid=false)
 func ResourcePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePolicyAttachmentCreate,
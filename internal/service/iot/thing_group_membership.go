@@ -20,6 +20,9 @@ import (
 )
 
 // @SDKResource("aws_iot_thing_group_membership")
+// @IDHelpers(parse="ThingGroupMembershipParseResourceID", create="ThingGroupMembershipCreateResourceID")
+// @IDExample("my-group/my-thing")
+// @IDExample("my-group", valid=false)
 func ResourceThingGroupMembership() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceThingGroupMembershipCreate,
@@ -41,6 +41,9 @@ const (
 
 // @SDKResource("aws_kendra_data_source", name="Data Source")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="DataSourceParseResourceID")
+// @IDExample("12345678-1234-1234-1234-123456789012/87654321-4321-4321-4321-210987654321")
+// @IDExample("12345678-1234-1234-1234-123456789012", valid=false)
 func ResourceDataSource() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDataSourceCreate,
@@ -30,6 +30,9 @@ import (
 )
 
 // @SDKResource("aws_kendra_experience")
+// @IDHelpers(parse="ExperienceParseResourceID")
+// @IDExample("12345678-1234-1234-1234-123456789012/87654321-4321-4321-4321-210987654321")
+// @IDExample("12345678-1234-1234-1234-123456789012", valid=false)
 func ResourceExperience() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceExperienceCreate,
@@ -32,6 +32,9 @@ import (
 
 // @SDKResource("aws_kendra_faq", name="FAQ")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="FaqParseResourceID")
+// @IDExample("12345678-1234-1234-1234-123456789012/87654321-4321-4321-4321-210987654321")
+// @IDExample("12345678-1234-1234-1234-123456789012", valid=false)
 func ResourceFaq() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFaqCreate,
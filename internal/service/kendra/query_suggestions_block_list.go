@@ -30,6 +30,9 @@ import (
 
 // @SDKResource("aws_kendra_query_suggestions_block_list", name="Query Suggestions Block List")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="QuerySuggestionsBlockListParseResourceID")
+// @IDExample("12345678-1234-1234-1234-123456789012/87654321-4321-4321-4321-210987654321")
+// @IDExample("12345678-1234-1234-1234-123456789012", valid=false)
 func ResourceQuerySuggestionsBlockList() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceQuerySuggestionsBlockListCreate,
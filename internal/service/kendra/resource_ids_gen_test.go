@@ -0,0 +1,162 @@
+// Code generated by internal/generate/idtests/main.go; DO NOT EDIT.
+
+package kendra
+
+import (
+	"testing"
+)
+
+func TestDataSourceResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"12345678-1234-1234-1234-123456789012/87654321-4321-4321-4321-210987654321": true,
+		"12345678-1234-1234-1234-123456789012":                                      false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := DataSourceParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("DataSourceParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("DataSourceParseResourceID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestExperienceResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"12345678-1234-1234-1234-123456789012/87654321-4321-4321-4321-210987654321": true,
+		"12345678-1234-1234-1234-123456789012":                                      false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := ExperienceParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ExperienceParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ExperienceParseResourceID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestFAQResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"12345678-1234-1234-1234-123456789012/87654321-4321-4321-4321-210987654321": true,
+		"12345678-1234-1234-1234-123456789012":                                      false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := FaqParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("FaqParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("FaqParseResourceID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestQuerySuggestionsBlockListResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"12345678-1234-1234-1234-123456789012/87654321-4321-4321-4321-210987654321": true,
+		"12345678-1234-1234-1234-123456789012":                                      false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := QuerySuggestionsBlockListParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("QuerySuggestionsBlockListParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("QuerySuggestionsBlockListParseResourceID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestThesaurusResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"12345678-1234-1234-1234-123456789012/87654321-4321-4321-4321-210987654321": true,
+		"12345678-1234-1234-1234-123456789012":                                      false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := ThesaurusParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ThesaurusParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ThesaurusParseResourceID(%q): %s", id, err)
+			}
+		})
+	}
+}
@@ -30,6 +30,9 @@ import (
 
 // @SDKResource("aws_kendra_thesaurus", name="Thesaurus")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ThesaurusParseResourceID")
+// @IDExample("12345678-1234-1234-1234-123456789012/87654321-4321-4321-4321-210987654321")
+// @IDExample("12345678-1234-1234-1234-123456789012", valid=false)
 func ResourceThesaurus() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceThesaurusCreate,
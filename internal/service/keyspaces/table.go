@@ -31,6 +31,9 @@ import (
 
 // @SDKResource("aws_keyspaces_table", name="Table")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="tableParseResourceID", create="tableCreateResourceID")
+// @IDExample("my_keyspace/my_table")
+// @IDExample("my_keyspace", valid=false)
 func resourceTable() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTableCreate,
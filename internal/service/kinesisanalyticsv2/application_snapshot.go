@@ -21,6 +21,9 @@ import (
 )
 
 // @SDKResource("aws_kinesisanalyticsv2_application_snapshot")
+// @IDHelpers(parse="applicationSnapshotParseID", create="applicationSnapshotCreateID")
+// @IDExample("my-application/my-snapshot")
+// @IDExample("my-application", valid=false)
 func ResourceApplicationSnapshot() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceApplicationSnapshotCreate,
@@ -34,6 +34,10 @@ import (
 )
 
 // @SDKResource("aws_kms_grant", name="Grant")
+// @IDHelpers(parse="grantParseResourceID", create="grantCreateResourceID")
+// @IDExample("1234abcd-12ab-34cd-56ef-1234567890ab:0123456789abcdef")
+// @IDExample("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab:0123456789abcdef")
+// @IDExample("1234abcd-12ab-34cd-56ef-1234567890ab", valid=false)
 func resourceGrant() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGrantCreate,
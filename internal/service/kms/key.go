@@ -31,7 +31,9 @@ import (
 )
 
 // @SDKResource("aws_kms_key", name="Key")
+// @Stateful
 // @Tags(identifierAttribute="id")
+// @ImportFromARN(service="kms", resource="key/{id}", id="{id}")
 func resourceKey() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKeyCreate,
@@ -26,6 +26,14 @@ import (
 )
 
 // @SDKResource("aws_lambda_function_event_invoke_config", name="Function Event Invoke Config")
+// @IDHelpers(parse="functionEventInvokeConfigParseResourceID")
+// @IDExample("my-function")
+// @IDExample("my-function:live")
+// @IDExample("arn:aws:lambda:us-west-2:123456789012:function:my-function")
+// @IDExample("arn:aws:lambda:us-west-2:123456789012:function:my-function:live")
+// @IDExample(":live", valid=false)
+// @IDExample("my-function:live:extra", valid=false)
+// @IDExample("arn:aws:lambda:us-west-2:123456789012:function:my-function:live:extra", valid=false)
 func resourceFunctionEventInvokeConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFunctionEventInvokeConfigCreate,
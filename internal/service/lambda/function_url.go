@@ -28,6 +28,11 @@ import (
 )
 
 // @SDKResource("aws_lambda_function_url", name="Function URL")
+// @IDHelpers(parse="functionURLParseResourceID", create="functionURLCreateResourceID")
+// @IDExample("my-function")
+// @IDExample("my-function/live")
+// @IDExample("my-function/live/extra", valid=false)
+// @IDExample("/live", valid=false)
 func resourceFunctionURL() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFunctionURLCreate,
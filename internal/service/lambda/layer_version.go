@@ -30,6 +30,11 @@ import (
 const mutexLayerKey = `aws_lambda_layer_version`
 
 // @SDKResource("aws_lambda_layer_version", name="Layer Version")
+// @IDHelpers(parse="layerVersionParseResourceID")
+// @IDExample("arn:aws:lambda:us-west-2:123456789012:layer:my-layer:1")
+// @IDExample("my-layer", valid=false)
+// @IDExample("arn:aws:lambda:us-west-2:123456789012:layer:my-layer", valid=false)
+// @IDExample("arn:aws:lambda:us-west-2:123456789012:layer:my-layer:latest", valid=false)
 func resourceLayerVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLayerVersionCreate,
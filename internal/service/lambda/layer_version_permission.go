@@ -33,6 +33,11 @@ const (
 )
 
 // @SDKResource("aws_lambda_layer_version_permission", name="Layer Version Permission")
+// @IDHelpers(parse="layerVersionPermissionParseResourceID")
+// @IDExample("my-layer,1")
+// @IDExample("arn:aws:lambda:us-west-2:123456789012:layer:my-layer,1")
+// @IDExample("my-layer", valid=false)
+// @IDExample("my-layer,latest", valid=false)
 func resourceLayerVersionPermission() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLayerVersionPermissionCreate,
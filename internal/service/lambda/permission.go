@@ -30,6 +30,12 @@ import (
 var functionRegexp = `^(arn:[\w-]+:lambda:)?([a-z]{2}-(?:[a-z]+-){1,2}\d{1}:)?(\d{12}:)?(function:)?([0-9A-Za-z_-]+)(:(\$LATEST|[0-9A-Za-z_-]+))?$`
 
 // @SDKResource("aws_lambda_permission", name="Permission")
+// @IDHelpers(parse="permissionParseImportID")
+// @IDExample("my-function/AllowExecutionFromS3")
+// @IDExample("my-function:live/AllowExecutionFromS3")
+// @IDExample("my-function", valid=false)
+// @IDExample("my-function/", valid=false)
+// @IDExample("my-function/AllowExecutionFromS3/extra", valid=false)
 func resourcePermission() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePermissionCreate,
@@ -283,13 +289,11 @@ func resourcePermissionDelete(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourcePermissionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.Split(d.Id(), "/")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("Unexpected format of ID (%q), expected FUNCTION_NAME/STATEMENT_ID or FUNCTION_NAME:QUALIFIER/STATEMENT_ID", d.Id())
+	functionName, statementID, err := permissionParseImportID(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
-	functionName := idParts[0]
-	statementID := idParts[1]
 	input := &lambda.GetFunctionInput{
 		FunctionName: aws.String(functionName),
 	}
@@ -318,6 +322,15 @@ func resourcePermissionImport(ctx context.Context, d *schema.ResourceData, meta
 	return []*schema.ResourceData{d}, nil
 }
 
+func permissionParseImportID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected FUNCTION_NAME/STATEMENT_ID or FUNCTION_NAME:QUALIFIER/STATEMENT_ID", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
 func findPolicy(ctx context.Context, conn *lambda.Client, input *lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error) {
 	output, err := conn.GetPolicy(ctx, input)
 
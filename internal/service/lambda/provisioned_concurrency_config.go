@@ -20,12 +20,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_lambda_provisioned_concurrency_config", name="Provisioned Concurrency Config")
+// @Identity(attributes="function_name;qualifier")
 func resourceProvisionedConcurrencyConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProvisionedConcurrencyConfigCreate,
@@ -33,10 +34,6 @@ func resourceProvisionedConcurrencyConfig() *schema.Resource {
 		UpdateWithoutTimeout: resourceProvisionedConcurrencyConfigUpdate,
 		DeleteWithoutTimeout: resourceProvisionedConcurrencyConfigDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
 			Update: schema.DefaultTimeout(15 * time.Minute),
@@ -78,17 +75,13 @@ func resourceProvisionedConcurrencyConfig() *schema.Resource {
 	}
 }
 
-const (
-	provisionedConcurrencyConfigResourceIDPartCount = 2
-)
-
 func resourceProvisionedConcurrencyConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
 
 	functionName := d.Get("function_name").(string)
 	qualifier := d.Get("qualifier").(string)
-	id := errs.Must(flex.FlattenResourceId([]string{functionName, qualifier}, provisionedConcurrencyConfigResourceIDPartCount, true))
+	id := errs.Must(identity.FormatID(resourceProvisionedConcurrencyConfigIdentity, functionName, qualifier))
 	input := &lambda.PutProvisionedConcurrencyConfigInput{
 		FunctionName:                    aws.String(functionName),
 		ProvisionedConcurrentExecutions: aws.Int32(int32(d.Get("provisioned_concurrent_executions").(int))),
@@ -114,7 +107,7 @@ func resourceProvisionedConcurrencyConfigRead(ctx context.Context, d *schema.Res
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
 
-	parts, err := flex.ExpandResourceId(d.Id(), provisionedConcurrencyConfigResourceIDPartCount, false)
+	parts, err := identity.ParseID(resourceProvisionedConcurrencyConfigIdentity, d.Id())
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
@@ -144,7 +137,7 @@ func resourceProvisionedConcurrencyConfigUpdate(ctx context.Context, d *schema.R
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
 
-	parts, err := flex.ExpandResourceId(d.Id(), provisionedConcurrencyConfigResourceIDPartCount, false)
+	parts, err := identity.ParseID(resourceProvisionedConcurrencyConfigIdentity, d.Id())
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
@@ -174,7 +167,7 @@ func resourceProvisionedConcurrencyConfigDelete(ctx context.Context, d *schema.R
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
 
-	parts, err := flex.ExpandResourceId(d.Id(), provisionedConcurrencyConfigResourceIDPartCount, false)
+	parts, err := identity.ParseID(resourceProvisionedConcurrencyConfigIdentity, d.Id())
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
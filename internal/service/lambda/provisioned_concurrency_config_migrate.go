@@ -8,7 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/identity"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -53,7 +53,7 @@ func provisionedConcurrencyConfigStateUpgradeV0(ctx context.Context, rawState ma
 		rawState["qualifier"].(string),
 	}
 
-	id, err := flex.FlattenResourceId(parts, provisionedConcurrencyConfigResourceIDPartCount, false)
+	id, err := identity.FormatID(resourceProvisionedConcurrencyConfigIdentity, parts...)
 	if err != nil {
 		return rawState, err
 	}
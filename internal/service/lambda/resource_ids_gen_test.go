@@ -0,0 +1,182 @@
+// Code generated by internal/generate/idtests/main.go; DO NOT EDIT.
+
+package lambda
+
+import (
+	"testing"
+)
+
+func TestFunctionEventInvokeConfigResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-function":      true,
+		"my-function:live": true,
+		"arn:aws:lambda:us-west-2:123456789012:function:my-function":      true, //lintignore:AWSAT003,AWSAT005
+		"arn:aws:lambda:us-west-2:123456789012:function:my-function:live": true, //lintignore:AWSAT003,AWSAT005
+		":live":                  false,
+		"my-function:live:extra": false,
+		"arn:aws:lambda:us-west-2:123456789012:function:my-function:live:extra": false, //lintignore:AWSAT003,AWSAT005
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := functionEventInvokeConfigParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("functionEventInvokeConfigParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("functionEventInvokeConfigParseResourceID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestFunctionURLResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-function":            true,
+		"my-function/live":       true,
+		"my-function/live/extra": false,
+		"/live":                  false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := functionURLParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("functionURLParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("functionURLParseResourceID(%q): %s", id, err)
+			}
+
+			got := functionURLCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("functionURLCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestLayerVersionResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"arn:aws:lambda:us-west-2:123456789012:layer:my-layer:1": true, //lintignore:AWSAT003,AWSAT005
+		"my-layer": false,
+		"arn:aws:lambda:us-west-2:123456789012:layer:my-layer":        false, //lintignore:AWSAT003,AWSAT005
+		"arn:aws:lambda:us-west-2:123456789012:layer:my-layer:latest": false, //lintignore:AWSAT003,AWSAT005
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := layerVersionParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("layerVersionParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("layerVersionParseResourceID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestLayerVersionPermissionResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-layer,1": true,
+		"arn:aws:lambda:us-west-2:123456789012:layer:my-layer,1": true, //lintignore:AWSAT003,AWSAT005
+		"my-layer":        false,
+		"my-layer,latest": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := layerVersionPermissionParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("layerVersionPermissionParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("layerVersionPermissionParseResourceID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestPermissionResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-function/AllowExecutionFromS3":       true,
+		"my-function:live/AllowExecutionFromS3":  true,
+		"my-function":                            false,
+		"my-function/":                           false,
+		"my-function/AllowExecutionFromS3/extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := permissionParseImportID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("permissionParseImportID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("permissionParseImportID(%q): %s", id, err)
+			}
+		})
+	}
+}
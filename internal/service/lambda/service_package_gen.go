@@ -14,6 +14,13 @@ import (
 
 type servicePackage struct{}
 
+var (
+	resourceProvisionedConcurrencyConfigIdentity = &types.ServicePackageResourceIdentity{
+		IDAttributes: []string{"function_name", "qualifier"},
+		IDSeparator:  ",",
+	}
+)
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{}
 }
@@ -91,6 +98,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Import: &types.ServicePackageResourceImport{
+				ARNService:  "lambda",
+				ARNResource: "function:{name}",
+				ID:          "{name}",
+			},
 		},
 		{
 			Factory:  resourceFunctionEventInvokeConfig,
@@ -126,6 +138,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceProvisionedConcurrencyConfig,
 			TypeName: "aws_lambda_provisioned_concurrency_config",
 			Name:     "Provisioned Concurrency Config",
+			Identity: resourceProvisionedConcurrencyConfigIdentity,
 		},
 	}
 }
@@ -22,6 +22,9 @@ import (
 )
 
 // @SDKResource("aws_licensemanager_association")
+// @IDHelpers(parse="AssociationParseResourceID", create="AssociationCreateResourceID")
+// @IDExample("arn:aws:ec2:us-west-2:123456789012:instance/i-0123456789abcdef0,arn:aws:license-manager:us-west-2:123456789012:license-configuration:lic-0123456789abcdef0123456789abcdef")
+// @IDExample("arn:aws:ec2:us-west-2:123456789012:instance/i-0123456789abcdef0", valid=false)
 func ResourceAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAssociationCreate,
@@ -27,6 +27,10 @@ import (
 )
 
 // @SDKResource("aws_lightsail_container_service_deployment_version")
+// @IDHelpers(parse="ContainerServiceDeploymentVersionParseResourceID")
+// @IDExample("my-service/1")
+// @IDExample("my-service", valid=false)
+// @IDExample("my-service/one", valid=false)
 func ResourceContainerServiceDeploymentVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceContainerServiceDeploymentVersionCreate,
@@ -26,6 +26,9 @@ import (
 )
 
 // @SDKResource("aws_location_tracker_association")
+// @IDHelpers(parse="TrackerAssociationParseID")
+// @IDExample("my-tracker|arn:aws:geo:us-west-2:123456789012:geofence-collection/my-collection")
+// @IDExample("my-tracker", valid=false)
 func ResourceTrackerAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTrackerAssociationCreate,
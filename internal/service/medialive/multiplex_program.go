@@ -34,6 +34,10 @@ import (
 )
 
 // @FrameworkResource
+// @IDHelpers(parse="ParseMultiplexProgramID")
+// @IDExample("my-program/1234567")
+// @IDExample("my-program", valid=false)
+// @IDExample("/1234567", valid=false)
 func newResourceMultiplexProgram(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &multiplexProgram{}, nil
 }
@@ -17,6 +17,7 @@ import (
 )
 
 // @FrameworkDataSource
+// @Region(global=true)
 func newDataSourceARN(context.Context) (datasource.DataSourceWithConfigure, error) {
 	d := &dataSourceARN{}
 
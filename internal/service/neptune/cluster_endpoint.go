@@ -29,6 +29,9 @@ import (
 
 // @SDKResource("aws_neptune_cluster_endpoint", name="Cluster Endpoint")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="clusterEndpointParseResourceID", create="clusterEndpointCreateResourceID")
+// @IDExample("my-cluster:my-endpoint")
+// @IDExample("my-cluster", valid=false)
 func ResourceClusterEndpoint() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterEndpointCreate,
@@ -30,6 +30,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_connect_peer", name="Connect Peer")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceConnectPeer() *schema.Resource {
 	return &schema.Resource{
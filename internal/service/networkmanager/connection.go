@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_connection", name="Connection")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceConnection() *schema.Resource {
 	return &schema.Resource{
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_connection")
+// @Region(global=true)
 func DataSourceConnection() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceConnectionRead,
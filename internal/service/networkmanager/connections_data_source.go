@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_connections")
+// @Region(global=true)
 func DataSourceConnections() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceConnectionsRead,
@@ -41,6 +41,7 @@ const (
 )
 
 // @SDKResource("aws_networkmanager_core_network", name="Core Network")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceCoreNetwork() *schema.Resource {
 	return &schema.Resource{
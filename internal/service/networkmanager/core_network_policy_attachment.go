@@ -22,6 +22,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_core_network_policy_attachment")
+// @Region(global=true)
 func ResourceCoreNetworkPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCoreNetworkPolicyAttachmentCreate,
@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_core_network_policy_document")
+// @Region(global=true)
 func DataSourceCoreNetworkPolicyDocument() *schema.Resource {
 	setOfString := &schema.Schema{
 		Type:     schema.TypeSet,
@@ -23,6 +23,10 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_customer_gateway_association")
+// @Region(global=true)
+// @IDHelpers(parse="CustomerGatewayAssociationParseResourceID", create="CustomerGatewayAssociationCreateResourceID")
+// @IDExample("global-network-0123456789abcdef0,arn:aws:ec2:us-west-2:123456789012:customer-gateway/cgw-0123456789abcdef0")
+// @IDExample("global-network-0123456789abcdef0", valid=false)
 func ResourceCustomerGatewayAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCustomerGatewayAssociationCreate,
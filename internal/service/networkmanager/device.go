@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_device", name="Device")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceDevice() *schema.Resource {
 	return &schema.Resource{
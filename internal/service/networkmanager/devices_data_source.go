@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_devices")
+// @Region(global=true)
 func DataSourceDevices() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceDevicesRead,
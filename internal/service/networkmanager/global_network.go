@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_global_network", name="Global Network")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceGlobalNetwork() *schema.Resource {
 	return &schema.Resource{
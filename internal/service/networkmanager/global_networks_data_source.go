@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_global_networks")
+// @Region(global=true)
 func DataSourceGlobalNetworks() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceGlobalNetworksRead,
@@ -22,6 +22,10 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_link_association")
+// @Region(global=true)
+// @IDHelpers(parse="LinkAssociationParseResourceID", create="LinkAssociationCreateResourceID")
+// @IDExample("global-network-0123456789abcdef0,link-0123456789abcdef0,device-0123456789abcdef0")
+// @IDExample("global-network-0123456789abcdef0,link-0123456789abcdef0", valid=false)
 func ResourceLinkAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLinkAssociationCreate,
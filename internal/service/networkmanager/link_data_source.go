@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_link")
+// @Region(global=true)
 func DataSourceLink() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceLinkRead,
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_links")
+// @Region(global=true)
 func DataSourceLinks() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceLinksRead,
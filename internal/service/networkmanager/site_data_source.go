@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_site")
+// @Region(global=true)
 func DataSourceSite() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceSiteRead,
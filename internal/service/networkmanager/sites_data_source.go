@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_networkmanager_sites")
+// @Region(global=true)
 func DataSourceSites() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceSitesRead,
@@ -23,6 +23,10 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_transit_gateway_connect_peer_association")
+// @Region(global=true)
+// @IDHelpers(parse="TransitGatewayConnectPeerAssociationParseResourceID", create="TransitGatewayConnectPeerAssociationCreateResourceID")
+// @IDExample("global-network-0123456789abcdef0,arn:aws:ec2:us-west-2:123456789012:transit-gateway-connect-peer/tgw-connect-peer-0123456789abcdef0")
+// @IDExample("global-network-0123456789abcdef0", valid=false)
 func ResourceTransitGatewayConnectPeerAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayConnectPeerAssociationCreate,
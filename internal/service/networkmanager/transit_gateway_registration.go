@@ -24,6 +24,10 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_transit_gateway_registration")
+// @Region(global=true)
+// @IDHelpers(parse="TransitGatewayRegistrationParseResourceID", create="TransitGatewayRegistrationCreateResourceID")
+// @IDExample("global-network-0123456789abcdef0,arn:aws:ec2:us-west-2:123456789012:transit-gateway/tgw-0123456789abcdef0")
+// @IDExample("global-network-0123456789abcdef0", valid=false)
 func ResourceTransitGatewayRegistration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTransitGatewayRegistrationCreate,
@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_networkmanager_vpc_attachment", name="VPC Attachment")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceVPCAttachment() *schema.Resource {
 	return &schema.Resource{
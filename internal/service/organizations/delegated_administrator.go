@@ -26,6 +26,10 @@ import (
 )
 
 // @SDKResource("aws_organizations_delegated_administrator", name="Delegated Administrator")
+// @Region(global=true)
+// @IDHelpers(parse="delegatedAdministratorParseResourceID", create="delegatedAdministratorCreateResourceID")
+// @IDExample("123456789012/config.amazonaws.com")
+// @IDExample("123456789012", valid=false)
 func resourceDelegatedAdministrator() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDelegatedAdministratorCreate,
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_delegated_administrators", name="Delegated Administrators")
+// @Region(global=true)
 func dataSourceDelegatedAdministrators() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceDelegatedAdministratorsRead,
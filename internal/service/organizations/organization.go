@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_organizations_organization", name="Organization")
+// @Region(global=true)
 func resourceOrganization() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOrganizationCreate,
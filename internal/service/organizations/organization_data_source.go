@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_organization", name="Organization")
+// @Region(global=true)
 func dataSourceOrganization() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOrganizationRead,
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_organizations_organizational_unit", name="Organizational Unit")
+// @Region(global=true)
 // @Tags(identifierAttribute="id")
 func resourceOrganizationalUnit() *schema.Resource {
 	return &schema.Resource{
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_organizational_unit_child_accounts", name="Organizational Unit Child Accounts")
+// @Region(global=true)
 func dataSourceOrganizationalUnitChildAccounts() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOrganizationalUnitChildAccountsRead,
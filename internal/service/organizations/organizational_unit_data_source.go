@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_organizational_unit", name="Organizational Unit")
+// @Region(global=true)
 func dataSourceOrganizationalUnit() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOrganizationalUnitRead,
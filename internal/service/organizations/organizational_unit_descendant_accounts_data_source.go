@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_organizational_unit_descendant_accounts", name="Organizational Unit Descendant Accounts")
+// @Region(global=true)
 func dataSourceOrganizationalUnitDescendantAccounts() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOrganizationalUnitDescendantAccountsRead,
@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_organizational_units", name="Organizational Unit")
+// @Region(global=true)
 func dataSourceOrganizationalUnits() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceOrganizationalUnitsRead,
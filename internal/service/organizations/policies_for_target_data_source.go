@@ -18,6 +18,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_policies_for_target", name="Policies For Target")
+// @Region(global=true)
 func dataSourcePoliciesForTarget() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePoliciesForTargetRead,
@@ -24,6 +24,11 @@ import (
 )
 
 // @SDKResource("aws_organizations_policy_attachment", name="Policy Attachment")
+// @Region(global=true)
+// @IDHelpers(parse="policyAttachmentParseResourceID", create="policyAttachmentCreateResourceID")
+// @IDExample("123456789012:p-abcdefgh")
+// @IDExample("123456789012", valid=false)
+// @IDExample("r-ab12:p-abcdefgh:extra", valid=false)
 func resourcePolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePolicyAttachmentCreate,
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_organizations_policy", name="Policy")
+// @Region(global=true)
 func dataSourcePolicy() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourcePolicyRead,
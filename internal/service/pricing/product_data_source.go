@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_pricing_product")
+// @Region(global=true)
 func dataSourceProduct() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceProductRead,
@@ -34,6 +34,10 @@ const (
 
 // @SDKResource("aws_quicksight_analysis", name="Analysis")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ParseAnalysisId", create="createAnalysisId")
+// @IDExample("123456789012,my-analysis")
+// @IDExample("123456789012", valid=false)
+// @IDExample(",my-analysis", valid=false)
 func ResourceAnalysis() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAnalysisCreate,
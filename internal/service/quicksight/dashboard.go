@@ -29,6 +29,10 @@ import (
 
 // @SDKResource("aws_quicksight_dashboard", name="Dashboard")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ParseDashboardId", create="createDashboardId")
+// @IDExample("123456789012,my-dashboard")
+// @IDExample("123456789012", valid=false)
+// @IDExample(",my-dashboard", valid=false)
 func ResourceDashboard() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDashboardCreate,
@@ -27,6 +27,10 @@ import (
 
 // @SDKResource("aws_quicksight_data_set", name="Data Set")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ParseDataSetID", create="createDataSetID")
+// @IDExample("123456789012,my-data-set")
+// @IDExample("123456789012", valid=false)
+// @IDExample(",my-data-set", valid=false)
 func ResourceDataSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDataSetCreate,
@@ -23,6 +23,10 @@ import (
 
 // @SDKResource("aws_quicksight_data_source", name="Data Source")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ParseDataSourceID")
+// @IDExample("123456789012/my-data-source")
+// @IDExample("123456789012", valid=false)
+// @IDExample("/my-data-source", valid=false)
 func ResourceDataSource() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDataSourceCreate,
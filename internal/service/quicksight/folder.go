@@ -29,6 +29,10 @@ import (
 
 // @SDKResource("aws_quicksight_folder", name="Folder")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ParseFolderId", create="createFolderId")
+// @IDExample("123456789012,my-folder")
+// @IDExample("123456789012", valid=false)
+// @IDExample(",my-folder", valid=false)
 func ResourceFolder() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFolderCreate,
@@ -30,6 +30,9 @@ import (
 
 // Function annotations are used for resource registration to the Provider. DO NOT EDIT.
 // @FrameworkResource(name="Folder Membership")
+// @IDHelpers(parse="ParseFolderMembershipID", create="createFolderMembershipID")
+// @IDExample("123456789012,my-folder,DATASET,my-data-set")
+// @IDExample("123456789012,my-folder,DATASET", valid=false)
 func newResourceFolderMembership(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceFolderMembership{}, nil
 }
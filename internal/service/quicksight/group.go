@@ -26,6 +26,10 @@ const (
 )
 
 // @SDKResource("aws_quicksight_group", name="Group")
+// @IDHelpers(parse="GroupParseID")
+// @IDExample("123456789012/default/my-group")
+// @IDExample("123456789012/default", valid=false)
+// @IDExample("123456789012//my-group", valid=false)
 func ResourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
@@ -21,6 +21,10 @@ import (
 )
 
 // @SDKResource("aws_quicksight_group_membership", name="Group Membership")
+// @IDHelpers(parse="GroupMembershipParseID")
+// @IDExample("123456789012/default/my-group/my-user")
+// @IDExample("123456789012/default/my-group", valid=false)
+// @IDExample("123456789012/default//my-user", valid=false)
 func ResourceGroupMembership() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupMembershipCreate,
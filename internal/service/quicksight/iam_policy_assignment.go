@@ -33,6 +33,9 @@ import (
 )
 
 // @FrameworkResource(name="IAM Policy Assignment")
+// @IDHelpers(parse="ParseIAMPolicyAssignmentID", create="createIAMPolicyAssignmentID")
+// @IDExample("123456789012,default,my-assignment")
+// @IDExample("123456789012,default", valid=false)
 func newResourceIAMPolicyAssignment(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceIAMPolicyAssignment{}, nil
 }
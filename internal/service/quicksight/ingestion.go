@@ -29,6 +29,9 @@ import (
 )
 
 // @FrameworkResource(name="Ingestion")
+// @IDHelpers(parse="ParseIngestionID", create="createIngestionID")
+// @IDExample("123456789012,my-data-set,my-ingestion")
+// @IDExample("123456789012,my-data-set", valid=false)
 func newResourceIngestion(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceIngestion{}, nil
 }
@@ -32,6 +32,10 @@ import (
 
 // @FrameworkResource(name="Namespace")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ParseNamespaceID", create="createNamespaceID")
+// @IDExample("123456789012,my-namespace")
+// @IDExample("123456789012", valid=false)
+// @IDExample("123456789012,my-namespace,extra", valid=false)
 func newResourceNamespace(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceNamespace{}
 	r.SetDefaultCreateTimeout(2 * time.Minute)
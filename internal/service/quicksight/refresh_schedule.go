@@ -35,6 +35,10 @@ import (
 )
 
 // @FrameworkResource(name="Refresh Schedule")
+// @IDHelpers(parse="ParseRefreshScheduleID", create="createRefreshScheduleID")
+// @IDExample("123456789012,my-data-set,my-schedule")
+// @IDExample("123456789012,my-data-set", valid=false)
+// @IDExample("123456789012,,my-schedule", valid=false)
 func newResourceRefreshSchedule(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceRefreshSchedule{}, nil
 }
@@ -0,0 +1,625 @@
+// Code generated by internal/generate/idtests/main.go; DO NOT EDIT.
+
+package quicksight
+
+import (
+	"testing"
+)
+
+func TestAnalysisResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012,my-analysis": true,
+		"123456789012":             false,
+		",my-analysis":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseAnalysisId(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseAnalysisId(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseAnalysisId(%q): %s", id, err)
+			}
+
+			got := createAnalysisId(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("createAnalysisId = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDashboardResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012,my-dashboard": true,
+		"123456789012":              false,
+		",my-dashboard":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseDashboardId(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseDashboardId(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseDashboardId(%q): %s", id, err)
+			}
+
+			got := createDashboardId(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("createDashboardId = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDataSetResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012,my-data-set": true,
+		"123456789012":             false,
+		",my-data-set":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseDataSetID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseDataSetID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseDataSetID(%q): %s", id, err)
+			}
+
+			got := createDataSetID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("createDataSetID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDataSourceResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012/my-data-source": true,
+		"123456789012":                false,
+		"/my-data-source":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := ParseDataSourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseDataSourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseDataSourceID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestFolderResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012,my-folder": true,
+		"123456789012":           false,
+		",my-folder":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseFolderId(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseFolderId(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseFolderId(%q): %s", id, err)
+			}
+
+			got := createFolderId(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("createFolderId = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFolderMembershipResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012,my-folder,DATASET,my-data-set": true,
+		"123456789012,my-folder,DATASET":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, part3, err := ParseFolderMembershipID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseFolderMembershipID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseFolderMembershipID(%q): %s", id, err)
+			}
+
+			got := createFolderMembershipID(part0, part1, part2, part3)
+
+			if want := id; got != want {
+				t.Errorf("createFolderMembershipID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestGroupResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012/default/my-group": true,
+		"123456789012/default":          false,
+		"123456789012//my-group":        false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, _, err := GroupParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("GroupParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("GroupParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestGroupMembershipResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012/default/my-group/my-user": true,
+		"123456789012/default/my-group":         false,
+		"123456789012/default//my-user":         false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, _, _, err := GroupMembershipParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("GroupMembershipParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("GroupMembershipParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyAssignmentResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012,default,my-assignment": true,
+		"123456789012,default":               false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := ParseIAMPolicyAssignmentID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseIAMPolicyAssignmentID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseIAMPolicyAssignmentID(%q): %s", id, err)
+			}
+
+			got := createIAMPolicyAssignmentID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("createIAMPolicyAssignmentID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestIngestionResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012,my-data-set,my-ingestion": true,
+		"123456789012,my-data-set":              false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := ParseIngestionID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseIngestionID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseIngestionID(%q): %s", id, err)
+			}
+
+			got := createIngestionID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("createIngestionID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestNamespaceResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012,my-namespace":       true,
+		"123456789012":                    false,
+		"123456789012,my-namespace,extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseNamespaceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseNamespaceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseNamespaceID(%q): %s", id, err)
+			}
+
+			got := createNamespaceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("createNamespaceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestRefreshScheduleResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012,my-data-set,my-schedule": true,
+		"123456789012,my-data-set":             false,
+		"123456789012,,my-schedule":            false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := ParseRefreshScheduleID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseRefreshScheduleID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseRefreshScheduleID(%q): %s", id, err)
+			}
+
+			got := createRefreshScheduleID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("createRefreshScheduleID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTemplateResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012,my-template": true,
+		"123456789012":             false,
+		",my-template":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseTemplateId(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseTemplateId(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseTemplateId(%q): %s", id, err)
+			}
+
+			got := createTemplateId(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("createTemplateId = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTemplateAliasResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012,my-template,my-alias": true,
+		"123456789012,my-template":          false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := ParseTemplateAliasID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseTemplateAliasID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseTemplateAliasID(%q): %s", id, err)
+			}
+
+			got := createTemplateAliasID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("createTemplateAliasID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestThemeResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012,my-theme": true,
+		"123456789012":          false,
+		",my-theme":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseThemeId(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseThemeId(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseThemeId(%q): %s", id, err)
+			}
+
+			got := createThemeId(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("createThemeId = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestUserResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012/default/my-user": true,
+		"123456789012/default":         false,
+		"123456789012//my-user":        false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, _, err := UserParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("UserParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("UserParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestVPCConnectionResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"123456789012,my-vpc-connection": true,
+		"123456789012":                   false,
+		",my-vpc-connection":             false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseVPCConnectionID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseVPCConnectionID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseVPCConnectionID(%q): %s", id, err)
+			}
+
+			got := createVPCConnectionID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("createVPCConnectionID = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -28,6 +28,10 @@ import (
 
 // @SDKResource("aws_quicksight_template", name="Template")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ParseTemplateId", create="createTemplateId")
+// @IDExample("123456789012,my-template")
+// @IDExample("123456789012", valid=false)
+// @IDExample(",my-template", valid=false)
 func ResourceTemplate() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTemplateCreate,
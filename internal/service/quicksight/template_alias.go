@@ -27,6 +27,9 @@ import (
 )
 
 // @FrameworkResource(name="Template Alias")
+// @IDHelpers(parse="ParseTemplateAliasID", create="createTemplateAliasID")
+// @IDExample("123456789012,my-template,my-alias")
+// @IDExample("123456789012,my-template", valid=false)
 func newResourceTemplateAlias(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceTemplateAlias{}, nil
 }
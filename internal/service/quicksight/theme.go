@@ -29,6 +29,10 @@ import (
 
 // @SDKResource("aws_quicksight_theme", name="Theme")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ParseThemeId", create="createThemeId")
+// @IDExample("123456789012,my-theme")
+// @IDExample("123456789012", valid=false)
+// @IDExample(",my-theme", valid=false)
 func ResourceTheme() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceThemeCreate,
@@ -26,6 +26,10 @@ const (
 )
 
 // @SDKResource("aws_quicksight_user", name="User")
+// @IDHelpers(parse="UserParseID")
+// @IDExample("123456789012/default/my-user")
+// @IDExample("123456789012/default", valid=false)
+// @IDExample("123456789012//my-user", valid=false)
 func ResourceUser() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserCreate,
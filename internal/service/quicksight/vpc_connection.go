@@ -35,6 +35,10 @@ import (
 
 // @FrameworkResource(name="VPC Connection")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="ParseVPCConnectionID", create="createVPCConnectionID")
+// @IDExample("123456789012,my-vpc-connection")
+// @IDExample("123456789012", valid=false)
+// @IDExample(",my-vpc-connection", valid=false)
 func newResourceVPCConnection(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceVPCConnection{}
 	r.SetDefaultCreateTimeout(5 * time.Minute)
@@ -22,6 +22,9 @@ import (
 )
 
 // @SDKResource("aws_rds_cluster_role_association")
+// @IDHelpers(parse="ClusterRoleAssociationParseResourceID", create="ClusterRoleAssociationCreateResourceID")
+// @IDExample("my-cluster,arn:aws:iam::123456789012:role/my-role")
+// @IDExample("my-cluster", valid=false)
 func ResourceClusterRoleAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterRoleAssociationCreate,
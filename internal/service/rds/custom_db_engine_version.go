@@ -34,6 +34,10 @@ const cevMutexKey = `aws_rds_custom_engine_version`
 
 // @SDKResource("aws_rds_custom_db_engine_version", name="Custom DB Engine Version")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="customEngineVersionParseID")
+// @IDExample("custom-oracle-ee:19.cdb_cev1")
+// @IDExample("custom-oracle-ee", valid=false)
+// @IDExample(":19.cdb_cev1", valid=false)
 func ResourceCustomDBEngineVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCustomDBEngineVersionCreate,
@@ -30,6 +30,10 @@ import (
 
 // @SDKResource("aws_db_proxy_endpoint", name="DB Proxy Endpoint")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="proxyEndpointParseResourceID", create="proxyEndpointCreateResourceID")
+// @IDExample("my-proxy/my-endpoint")
+// @IDExample("my-proxy", valid=false)
+// @IDExample("/my-endpoint", valid=false)
 func resourceProxyEndpoint() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProxyEndpointCreate,
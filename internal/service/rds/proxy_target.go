@@ -25,6 +25,10 @@ import (
 )
 
 // @SDKResource("aws_db_proxy_target", name="DB Proxy Target")
+// @IDHelpers(parse="proxyTargetParseResourceID", create="proxyTargetCreateResourceID")
+// @IDExample("my-proxy/default/RDS_INSTANCE/my-instance")
+// @IDExample("my-proxy/default/TRACKED_CLUSTER/my-cluster")
+// @IDExample("my-proxy/default/RDS_INSTANCE", valid=false)
 func resourceProxyTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProxyTargetCreate,
@@ -0,0 +1,152 @@
+// Code generated by internal/generate/idtests/main.go; DO NOT EDIT.
+
+package rds
+
+import (
+	"testing"
+)
+
+func TestClusterRoleAssociationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-cluster,arn:aws:iam::123456789012:role/my-role": true, //lintignore:AWSAT005
+		"my-cluster": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ClusterRoleAssociationParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ClusterRoleAssociationParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ClusterRoleAssociationParseResourceID(%q): %s", id, err)
+			}
+
+			got := ClusterRoleAssociationCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("ClusterRoleAssociationCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestCustomDBEngineVersionResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"custom-oracle-ee:19.cdb_cev1": true,
+		"custom-oracle-ee":             false,
+		":19.cdb_cev1":                 false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := customEngineVersionParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("customEngineVersionParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("customEngineVersionParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestDBProxyEndpointResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-proxy/my-endpoint": true,
+		"my-proxy":             false,
+		"/my-endpoint":         false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := proxyEndpointParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("proxyEndpointParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("proxyEndpointParseResourceID(%q): %s", id, err)
+			}
+
+			got := proxyEndpointCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("proxyEndpointCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDBProxyTargetResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-proxy/default/RDS_INSTANCE/my-instance":   true,
+		"my-proxy/default/TRACKED_CLUSTER/my-cluster": true,
+		"my-proxy/default/RDS_INSTANCE":               false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, part3, err := proxyTargetParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("proxyTargetParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("proxyTargetParseResourceID(%q): %s", id, err)
+			}
+
+			got := proxyTargetCreateResourceID(part0, part1, part2, part3)
+
+			if want := id; got != want {
+				t.Errorf("proxyTargetCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -23,6 +23,9 @@ import (
 )
 
 // @SDKResource("aws_redshift_snapshot_schedule_association", name="Snapshot Schedule Association")
+// @IDHelpers(parse="SnapshotScheduleAssociationParseResourceID", create="SnapshotScheduleAssociationCreateResourceID")
+// @IDExample("my-cluster/my-schedule")
+// @IDExample("my-cluster", valid=false)
 func resourceSnapshotScheduleAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSnapshotScheduleAssociationCreate,
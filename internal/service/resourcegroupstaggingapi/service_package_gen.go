@@ -28,6 +28,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceResources,
 			TypeName: "aws_resourcegroupstaggingapi_resources",
 		},
+		{
+			Factory:  dataSourceTagCompliance,
+			TypeName: "aws_resourcegroupstaggingapi_tag_compliance",
+			Name:     "Tag Compliance",
+		},
 	}
 }
 
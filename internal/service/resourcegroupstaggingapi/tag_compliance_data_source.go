@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_resourcegroupstaggingapi_tag_compliance", name="Tag Compliance")
+func dataSourceTagCompliance() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTagComplianceRead,
+
+		Schema: map[string]*schema.Schema{
+			"include_default_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"noncompliant_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"divergent_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expected_tags": tftags.TagsSchemaComputed(),
+						"missing_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrResourceARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrTags: tftags.TagsSchemaComputed(),
+					},
+				},
+			},
+			"required_keys": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"required_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_type_filters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceTagComplianceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	requiredTags := tftags.New(ctx, d.Get("required_tags").(map[string]interface{}))
+	if d.Get("include_default_tags").(bool) {
+		requiredTags = meta.(*conns.AWSClient).DefaultTagsConfig.MergeTags(requiredTags)
+	}
+
+	// Keys of required tags are also required.
+	requiredKeys := tftags.New(ctx, d.Get("required_keys").(*schema.Set).List()).Merge(requiredTags)
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{}
+
+	if v, ok := d.GetOk("resource_type_filters"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceTypeFilters = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	var noncompliantResources []map[string]interface{}
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Resources: %s", err)
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			tags := KeyValueTags(ctx, v.Tags)
+			missing, divergent, expected := tagCompliance(tags, requiredKeys, requiredTags)
+
+			if len(missing) == 0 && len(divergent) == 0 {
+				continue
+			}
+
+			noncompliantResources = append(noncompliantResources, map[string]interface{}{
+				"divergent_keys":      divergent.Keys(),
+				"expected_tags":       expected.Map(),
+				"missing_keys":        missing.Keys(),
+				names.AttrResourceARN: aws.ToString(v.ResourceARN),
+				names.AttrTags:        tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map(),
+			})
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("noncompliant_resources", noncompliantResources); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting noncompliant_resources: %s", err)
+	}
+
+	return diags
+}
+
+// tagCompliance compares a resource's tags with required tag keys and values.
+// It returns the required keys that are missing, the keys of required tags whose values differ,
+// and the required tags that must be applied to make the resource compliant.
+func tagCompliance(tags, requiredKeys, requiredTags tftags.KeyValueTags) (missing, divergent, expected tftags.KeyValueTags) {
+	missing = requiredKeys.Removed(tags)
+	expected = requiredTags.Difference(tags)
+	divergent = expected.Only(tags)
+
+	return missing, divergent, expected
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResourceGroupsTaggingAPITagComplianceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resourcegroupstaggingapi_tag_compliance.test"
+	resourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagComplianceDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "noncompliant_resources.*", map[string]string{
+						"divergent_keys.#":          acctest.Ct1,
+						"divergent_keys.0":          "Environment",
+						"expected_tags.%":           acctest.Ct1,
+						"expected_tags.Environment": "prod",
+						"missing_keys.#":            acctest.Ct1,
+						"missing_keys.0":            rName,
+						"tags.Name":                 rName,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "noncompliant_resources.*.resource_arn", resourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPITagComplianceDataSource_defaultTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resourcegroupstaggingapi_tag_compliance.test"
+	resourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("Environment", "prod"),
+					testAccTagComplianceDataSourceConfig_defaultTags(rName, true),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "noncompliant_resources.*", map[string]string{
+						"divergent_keys.#":          acctest.Ct1,
+						"divergent_keys.0":          "Environment",
+						"expected_tags.%":           acctest.Ct1,
+						"expected_tags.Environment": "prod",
+						"missing_keys.#":            acctest.Ct0,
+						"tags.Name":                 rName,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "noncompliant_resources.*.resource_arn", resourceName, names.AttrARN),
+					resource.TestCheckOutput("test_noncompliant_count", acctest.Ct1),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("Environment", "prod"),
+					testAccTagComplianceDataSourceConfig_defaultTags(rName, false),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("test_noncompliant_count", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccTagComplianceDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name        = %[1]q
+    Environment = "dev"
+  }
+}
+
+data "aws_resourcegroupstaggingapi_tag_compliance" "test" {
+  required_keys         = [%[1]q]
+  resource_type_filters = ["ec2:vpc"]
+
+  required_tags = {
+    Environment = "prod"
+  }
+
+  depends_on = [aws_vpc.test]
+}
+`, rName)
+}
+
+func testAccTagComplianceDataSourceConfig_defaultTags(rName string, includeDefaultTags bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name        = %[1]q
+    Environment = "dev"
+  }
+}
+
+data "aws_resourcegroupstaggingapi_tag_compliance" "test" {
+  include_default_tags  = %[2]t
+  resource_type_filters = ["ec2:vpc"]
+
+  required_tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_vpc.test]
+}
+
+output "test_noncompliant_count" {
+  value = length([for r in data.aws_resourcegroupstaggingapi_tag_compliance.test.noncompliant_resources : r if r.resource_arn == aws_vpc.test.arn])
+}
+`, rName, includeDefaultTags)
+}
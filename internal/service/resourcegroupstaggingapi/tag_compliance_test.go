@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestTagCompliance(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	requiredTags := tftags.New(ctx, map[string]string{
+		"CostCenter": "1234",
+		"ManagedBy":  "terraform",
+	})
+	requiredKeys := tftags.New(ctx, []string{"Owner"}).Merge(requiredTags)
+
+	testCases := []struct {
+		name          string
+		tags          tftags.KeyValueTags
+		wantMissing   []string
+		wantDivergent []string
+		wantExpected  map[string]string
+	}{
+		{
+			name: "compliant",
+			tags: tftags.New(ctx, map[string]string{
+				"CostCenter": "1234",
+				"ManagedBy":  "terraform",
+				"Owner":      "team",
+				"Other":      "value",
+			}),
+			wantMissing:   []string{},
+			wantDivergent: []string{},
+			wantExpected:  map[string]string{},
+		},
+		{
+			name:          "no tags",
+			tags:          tftags.New(ctx, map[string]string{}),
+			wantMissing:   []string{"CostCenter", "ManagedBy", "Owner"},
+			wantDivergent: []string{},
+			wantExpected: map[string]string{
+				"CostCenter": "1234",
+				"ManagedBy":  "terraform",
+			},
+		},
+		{
+			name: "divergent value",
+			tags: tftags.New(ctx, map[string]string{
+				"CostCenter": "5678",
+				"Owner":      "team",
+			}),
+			wantMissing:   []string{"ManagedBy"},
+			wantDivergent: []string{"CostCenter"},
+			wantExpected: map[string]string{
+				"CostCenter": "1234",
+				"ManagedBy":  "terraform",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			missing, divergent, expected := tagCompliance(testCase.tags, requiredKeys, requiredTags)
+
+			if diff := cmp.Diff(missing.Keys(), testCase.wantMissing, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("unexpected missing keys diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(divergent.Keys(), testCase.wantDivergent, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("unexpected divergent keys diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(expected.Map(), testCase.wantExpected); diff != "" {
+				t.Errorf("unexpected expected tags diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 )
 
 // @FrameworkResource
+// @Region(global=true)
 func newCIDRCollectionResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &cidrCollectionResource{}
 
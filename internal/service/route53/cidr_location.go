@@ -33,6 +33,7 @@ import (
 )
 
 // @FrameworkResource
+// @Region(global=true)
 func newCIDRLocationResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &cidrLocationResource{}
 
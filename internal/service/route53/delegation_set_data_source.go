@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_route53_delegation_set", name="Reusable Delegation Set")
+// @Region(global=true)
 func dataSourceDelegationSet() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceDelegationSetRead,
@@ -33,6 +33,7 @@ const (
 )
 
 // @SDKResource("aws_route53_hosted_zone_dnssec", name="Hosted Zone DNSSEC")
+// @Region(global=true)
 func resourceHostedZoneDNSSEC() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceHostedZoneDNSSECCreate,
@@ -31,6 +31,10 @@ import (
 )
 
 // @SDKResource("aws_route53_record", name="Record")
+// @Region(global=true)
+// @IDHelpers(parse="recordParseResourceID")
+// @IDExample("Z123456ABCDEFG_www.example.com_A")
+// @IDExample("Z123456ABCDEFG_www.example.com_A_dev")
 func resourceRecord() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_route53_traffic_policy_document", name="Traffic Policy Document")
+// @Region(global=true)
 func dataSourceTrafficPolicyDocument() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceTrafficPolicyDocumentRead,
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_route53_traffic_policy_instance", name="Traffic Policy Instance")
+// @Region(global=true)
 func resourceTrafficPolicyInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTrafficPolicyInstanceCreate,
@@ -25,6 +25,10 @@ import (
 )
 
 // @SDKResource("aws_route53_vpc_association_authorization", name="VPC Association Authorization")
+// @Region(global=true)
+// @IDHelpers(parse="vpcAssociationAuthorizationParseResourceID", create="vpcAssociationAuthorizationCreateResourceID")
+// @IDExample("Z123456ABCDEFG:vpc-12345678")
+// @IDExample("Z123456ABCDEFG", valid=false)
 func resourceVPCAssociationAuthorization() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVPCAssociationAuthorizationCreate,
@@ -33,6 +33,7 @@ import (
 )
 
 // @SDKResource("aws_route53_zone", name="Hosted Zone")
+// @Region(global=true)
 // @Tags(identifierAttribute="id", resourceType="hostedzone")
 func resourceZone() *schema.Resource {
 	return &schema.Resource{
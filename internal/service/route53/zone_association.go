@@ -26,6 +26,10 @@ import (
 )
 
 // @SDKResource("aws_route53_zone_association", name="Zone Association")
+// @Region(global=true)
+// @IDHelpers(parse="zoneAssociationParseResourceID", create="zoneAssociationCreateResourceID")
+// @IDExample("Z123456ABCDEFG:vpc-12345678:us-west-2")
+// @IDExample("Z123456ABCDEFG", valid=false)
 func resourceZoneAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceZoneAssociationCreate,
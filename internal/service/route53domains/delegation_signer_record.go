@@ -31,6 +31,7 @@ import (
 )
 
 // @FrameworkResource(name="Delegation Signer Record")
+// @Region(global=true)
 func newDelegationSignerRecordResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &delegationSignerRecordResource{}
 
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_route53recoverycontrolconfig_cluster")
+// @Region(global=true)
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_route53recoverycontrolconfig_control_panel")
+// @Region(global=true)
 func ResourceControlPanel() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceControlPanelCreate,
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKResource("aws_route53recoverycontrolconfig_routing_control")
+// @Region(global=true)
 func ResourceRoutingControl() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRoutingControlCreate,
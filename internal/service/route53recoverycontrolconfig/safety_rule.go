@@ -21,6 +21,7 @@ import (
 )
 
 // @SDKResource("aws_route53recoverycontrolconfig_safety_rule")
+// @Region(global=true)
 func ResourceSafetyRule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSafetyRuleCreate,
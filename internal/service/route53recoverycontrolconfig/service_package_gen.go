@@ -29,18 +29,30 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 		{
 			Factory:  ResourceCluster,
 			TypeName: "aws_route53recoverycontrolconfig_cluster",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceControlPanel,
 			TypeName: "aws_route53recoverycontrolconfig_control_panel",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceRoutingControl,
 			TypeName: "aws_route53recoverycontrolconfig_routing_control",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 		{
 			Factory:  ResourceSafetyRule,
 			TypeName: "aws_route53recoverycontrolconfig_safety_rule",
+			Region: &types.ServicePackageResourceRegion{
+				IsGlobal: true,
+			},
 		},
 	}
 }
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_route53recoveryreadiness_cell", name="Cell")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceCell() *schema.Resource {
 	return &schema.Resource{
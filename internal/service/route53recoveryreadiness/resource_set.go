@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_route53recoveryreadiness_resource_set", name="Resource Set")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func ResourceResourceSet() *schema.Resource {
 	return &schema.Resource{
@@ -23,6 +23,9 @@ import (
 )
 
 // @SDKResource("aws_route53_resolver_firewall_rule")
+// @IDHelpers(parse="FirewallRuleParseResourceID", create="FirewallRuleCreateResourceID")
+// @IDExample("rslvr-frg-0123456789abcdef:rslvr-fdl-0123456789abcdef")
+// @IDExample("rslvr-frg-0123456789abcdef", valid=false)
 func ResourceFirewallRule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFirewallRuleCreate,
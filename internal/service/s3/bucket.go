@@ -48,7 +48,9 @@ const (
 )
 
 // @SDKResource("aws_s3_bucket", name="Bucket")
+// @Stateful
 // @Tags(identifierAttribute="bucket", resourceType="Bucket")
+// @ImportFromARN(service="s3", resource="{bucket}", id="{bucket}")
 // @Testing(importIgnore="force_destroy")
 func resourceBucket() *schema.Resource {
 	return &schema.Resource{
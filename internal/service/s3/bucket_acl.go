@@ -30,6 +30,13 @@ import (
 const BucketACLSeparator = ","
 
 // @SDKResource("aws_s3_bucket_acl", name="Bucket ACL")
+// @IDHelpers(parse="BucketACLParseResourceID", create="BucketACLCreateResourceID")
+// @IDExample("my-bucket")
+// @IDExample("my-bucket,123456789012")
+// @IDExample("my-bucket,public-read")
+// @IDExample("my-bucket,123456789012,private")
+// @IDExample("my-bucket,12345,private", valid=false)
+// @IDExample("my-bucket,Private", valid=false)
 func resourceBucketACL() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketACLCreate,
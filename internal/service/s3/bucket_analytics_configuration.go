@@ -26,6 +26,10 @@ import (
 )
 
 // @SDKResource("aws_s3_bucket_analytics_configuration", name="Bucket Analytics Configuration")
+// @IDHelpers(parse="BucketAnalyticsConfigurationParseID")
+// @IDExample("my-bucket:EntireBucket")
+// @IDExample("my-bucket", valid=false)
+// @IDExample("my-bucket:EntireBucket:extra", valid=false)
 func resourceBucketAnalyticsConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketAnalyticsConfigurationPut,
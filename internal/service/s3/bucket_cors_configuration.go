@@ -23,6 +23,11 @@ import (
 )
 
 // @SDKResource("aws_s3_bucket_cors_configuration", name="Bucket CORS Configuration")
+// @IDHelpers(parse="ParseResourceID", create="CreateResourceID")
+// @IDExample("my-bucket")
+// @IDExample("my-bucket,123456789012")
+// @IDExample(",123456789012", valid=false)
+// @IDExample("my-bucket,123456789012,extra", valid=false)
 func resourceBucketCorsConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketCorsConfigurationCreate,
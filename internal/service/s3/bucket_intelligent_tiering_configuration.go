@@ -25,6 +25,9 @@ import (
 )
 
 // @SDKResource("aws_s3_bucket_intelligent_tiering_configuration", name="Bucket Intelligent-Tiering Configuration")
+// @IDHelpers(parse="BucketIntelligentTieringConfigurationParseResourceID", create="BucketIntelligentTieringConfigurationCreateResourceID")
+// @IDExample("my-bucket:my-config")
+// @IDExample("my-bucket", valid=false)
 func resourceBucketIntelligentTieringConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketIntelligentTieringConfigurationPut,
@@ -27,6 +27,10 @@ import (
 )
 
 // @SDKResource("aws_s3_bucket_inventory", name="Bucket Inventory")
+// @IDHelpers(parse="BucketInventoryParseID")
+// @IDExample("my-bucket:EntireBucket")
+// @IDExample("my-bucket", valid=false)
+// @IDExample("my-bucket:EntireBucket:extra", valid=false)
 func resourceBucketInventory() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketInventoryPut,
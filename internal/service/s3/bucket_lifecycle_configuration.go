@@ -30,6 +30,11 @@ import (
 )
 
 // @SDKResource("aws_s3_bucket_lifecycle_configuration", name="Bucket Lifecycle Configuration")
+// @IDHelpers(parse="ParseResourceID", create="CreateResourceID")
+// @IDExample("my-bucket")
+// @IDExample("my-bucket,123456789012")
+// @IDExample(",123456789012", valid=false)
+// @IDExample("my-bucket,123456789012,extra", valid=false)
 func resourceBucketLifecycleConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketLifecycleConfigurationCreate,
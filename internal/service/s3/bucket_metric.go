@@ -26,6 +26,10 @@ import (
 )
 
 // @SDKResource("aws_s3_bucket_metric", name="Bucket Metric")
+// @IDHelpers(parse="BucketMetricParseID")
+// @IDExample("my-bucket:EntireBucket")
+// @IDExample("my-bucket", valid=false)
+// @IDExample("my-bucket:EntireBucket:extra", valid=false)
 func resourceBucketMetric() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketMetricPut,
@@ -24,6 +24,11 @@ import (
 )
 
 // @SDKResource("aws_s3_bucket_object_lock_configuration", name="Bucket Object Lock Configuration")
+// @IDHelpers(parse="ParseResourceID", create="CreateResourceID")
+// @IDExample("my-bucket")
+// @IDExample("my-bucket,123456789012")
+// @IDExample(",123456789012", valid=false)
+// @IDExample("my-bucket,123456789012,extra", valid=false)
 func resourceBucketObjectLockConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBucketObjectLockConfigurationCreate,
@@ -46,6 +46,8 @@ import (
 // @Testing(importStateIdFunc=testAccObjectImportStateIdFunc)
 // @Testing(importIgnore="force_destroy")
 func resourceObject() *schema.Resource {
+	// TODO Support `moved` blocks from aws_s3_bucket_object once this resource is migrated to the Framework.
+	// See "Outstanding State Moves" in docs/terraform-plugin-migrations.md.
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceObjectCreate,
 		ReadWithoutTimeout:   resourceObjectRead,
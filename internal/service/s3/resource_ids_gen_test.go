@@ -0,0 +1,532 @@
+// Code generated by internal/generate/idtests/main.go; DO NOT EDIT.
+
+package s3
+
+import (
+	"testing"
+)
+
+func TestBucketACLResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket":                      true,
+		"my-bucket,123456789012":         true,
+		"my-bucket,public-read":          true,
+		"my-bucket,123456789012,private": true,
+		"my-bucket,12345,private":        false,
+		"my-bucket,Private":              false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := BucketACLParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("BucketACLParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("BucketACLParseResourceID(%q): %s", id, err)
+			}
+
+			got := BucketACLCreateResourceID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("BucketACLCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBucketAccelerateConfigurationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket":                    true,
+		"my-bucket,123456789012":       true,
+		",123456789012":                false,
+		"my-bucket,123456789012,extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseResourceID(%q): %s", id, err)
+			}
+
+			got := CreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("CreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBucketAnalyticsConfigurationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket:EntireBucket":       true,
+		"my-bucket":                    false,
+		"my-bucket:EntireBucket:extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := BucketAnalyticsConfigurationParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("BucketAnalyticsConfigurationParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("BucketAnalyticsConfigurationParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestBucketCORSConfigurationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket":                    true,
+		"my-bucket,123456789012":       true,
+		",123456789012":                false,
+		"my-bucket,123456789012,extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseResourceID(%q): %s", id, err)
+			}
+
+			got := CreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("CreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBucketIntelligentTieringConfigurationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket:my-config": true,
+		"my-bucket":           false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := BucketIntelligentTieringConfigurationParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("BucketIntelligentTieringConfigurationParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("BucketIntelligentTieringConfigurationParseResourceID(%q): %s", id, err)
+			}
+
+			got := BucketIntelligentTieringConfigurationCreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("BucketIntelligentTieringConfigurationCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBucketInventoryResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket:EntireBucket":       true,
+		"my-bucket":                    false,
+		"my-bucket:EntireBucket:extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := BucketInventoryParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("BucketInventoryParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("BucketInventoryParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestBucketLifecycleConfigurationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket":                    true,
+		"my-bucket,123456789012":       true,
+		",123456789012":                false,
+		"my-bucket,123456789012,extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseResourceID(%q): %s", id, err)
+			}
+
+			got := CreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("CreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBucketLoggingResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket":                    true,
+		"my-bucket,123456789012":       true,
+		",123456789012":                false,
+		"my-bucket,123456789012,extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseResourceID(%q): %s", id, err)
+			}
+
+			got := CreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("CreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBucketMetricResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket:EntireBucket":       true,
+		"my-bucket":                    false,
+		"my-bucket:EntireBucket:extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := BucketMetricParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("BucketMetricParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("BucketMetricParseID(%q): %s", id, err)
+			}
+		})
+	}
+}
+
+func TestBucketObjectLockConfigurationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket":                    true,
+		"my-bucket,123456789012":       true,
+		",123456789012":                false,
+		"my-bucket,123456789012,extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseResourceID(%q): %s", id, err)
+			}
+
+			got := CreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("CreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBucketRequestPaymentConfigurationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket":                    true,
+		"my-bucket,123456789012":       true,
+		",123456789012":                false,
+		"my-bucket,123456789012,extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseResourceID(%q): %s", id, err)
+			}
+
+			got := CreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("CreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBucketServersideEncryptionConfigurationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket":                    true,
+		"my-bucket,123456789012":       true,
+		",123456789012":                false,
+		"my-bucket,123456789012,extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseResourceID(%q): %s", id, err)
+			}
+
+			got := CreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("CreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBucketVersioningResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket":                    true,
+		"my-bucket,123456789012":       true,
+		",123456789012":                false,
+		"my-bucket,123456789012,extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseResourceID(%q): %s", id, err)
+			}
+
+			got := CreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("CreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestBucketWebsiteConfigurationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-bucket":                    true,
+		"my-bucket,123456789012":       true,
+		",123456789012":                false,
+		"my-bucket,123456789012,extra": false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseResourceID(%q): %s", id, err)
+			}
+
+			got := CreateResourceID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("CreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
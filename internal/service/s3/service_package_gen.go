@@ -80,6 +80,12 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrBucket,
 				ResourceType:        "Bucket",
 			},
+			Import: &types.ServicePackageResourceImport{
+				ARNService:  "s3",
+				ARNResource: "{bucket}",
+				ID:          "{bucket}",
+			},
+			Stateful: true,
 		},
 		{
 			Factory:  resourceBucketAccelerateConfiguration,
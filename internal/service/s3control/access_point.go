@@ -26,6 +26,10 @@ import (
 )
 
 // @SDKResource("aws_s3_access_point")
+// @IDHelpers(parse="AccessPointParseResourceID")
+// @IDExample("123456789012:my-access-point")
+// @IDExample("arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01ac5d28a6a232904/accesspoint/my-access-point")
+// @IDExample("my-access-point", valid=false)
 func resourceAccessPoint() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccessPointCreate,
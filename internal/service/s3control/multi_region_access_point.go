@@ -26,6 +26,9 @@ import (
 )
 
 // @SDKResource("aws_s3control_multi_region_access_point")
+// @IDHelpers(parse="MultiRegionAccessPointParseResourceID", create="MultiRegionAccessPointCreateResourceID")
+// @IDExample("123456789012:my-access-point")
+// @IDExample("123456789012", valid=false)
 func resourceMultiRegionAccessPoint() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceMultiRegionAccessPointCreate,
@@ -26,6 +26,9 @@ import (
 )
 
 // @SDKResource("aws_s3control_object_lambda_access_point")
+// @IDHelpers(parse="ObjectLambdaAccessPointParseResourceID", create="ObjectLambdaAccessPointCreateResourceID")
+// @IDExample("123456789012:my-access-point")
+// @IDExample("123456789012", valid=false)
 func resourceObjectLambdaAccessPoint() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceObjectLambdaAccessPointCreate,
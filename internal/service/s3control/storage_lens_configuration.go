@@ -29,6 +29,9 @@ import (
 
 // @SDKResource("aws_s3control_storage_lens_configuration", name="Storage Lens Configuration")
 // @Tags
+// @IDHelpers(parse="StorageLensConfigurationParseResourceID", create="StorageLensConfigurationCreateResourceID")
+// @IDExample("123456789012:my-config")
+// @IDExample("123456789012", valid=false)
 func resourceStorageLensConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStorageLensConfigurationCreate,
@@ -33,6 +33,10 @@ import (
 )
 
 // @SDKResource("aws_scheduler_schedule")
+// @IDHelpers(parse="ResourceScheduleParseID")
+// @IDExample("default/my-schedule")
+// @IDExample("my-schedule", valid=false)
+// @IDExample("default/my-schedule/extra", valid=false)
 func resourceSchedule() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceScheduleCreate,
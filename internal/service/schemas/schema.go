@@ -26,6 +26,9 @@ import (
 
 // @SDKResource("aws_schemas_schema", name="Schema")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="SchemaParseResourceID", create="SchemaCreateResourceID")
+// @IDExample("my-schema/my-registry")
+// @IDExample("my-schema", valid=false)
 func ResourceSchema() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSchemaCreate,
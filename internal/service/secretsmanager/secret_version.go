@@ -32,6 +32,10 @@ const (
 )
 
 // @SDKResource("aws_secretsmanager_secret_version", name="Secret Version")
+// @IDHelpers(parse="secretVersionParseResourceID", create="secretVersionCreateResourceID")
+// @IDExample("my-secret|00000000-0000-0000-0000-000000000000")
+// @IDExample("my-secret", valid=false)
+// @IDExample("|00000000-0000-0000-0000-000000000000", valid=false)
 func resourceSecretVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSecretVersionCreate,
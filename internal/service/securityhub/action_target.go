@@ -25,6 +25,10 @@ import (
 )
 
 // @SDKResource("aws_securityhub_action_target", name="Action Target")
+// @IDHelpers(parse="actionTargetParseID")
+// @IDExample("arn:aws:securityhub:us-west-2:123456789012:action/custom/my-action")
+// @IDExample("my-action", valid=false)
+// @IDExample("arn:aws:securityhub:us-west-2:123456789012:action/my-action", valid=false)
 func resourceActionTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceActionTargetCreate,
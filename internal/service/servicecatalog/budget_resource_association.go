@@ -20,6 +20,10 @@ import (
 )
 
 // @SDKResource("aws_servicecatalog_budget_resource_association")
+// @IDHelpers(parse="BudgetResourceAssociationParseID", create="BudgetResourceAssociationID")
+// @IDExample("my-budget:port-0123456789abc")
+// @IDExample("my-budget", valid=false)
+// @IDExample(":port-0123456789abc", valid=false)
 func ResourceBudgetResourceAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBudgetResourceAssociationCreate,
@@ -23,6 +23,10 @@ import (
 )
 
 // @SDKResource("aws_servicecatalog_portfolio_share")
+// @IDHelpers(parse="PortfolioShareParseResourceID", create="PortfolioShareCreateResourceID")
+// @IDExample("port-0123456789abc:ACCOUNT:123456789012")
+// @IDExample("port-0123456789abc:ORGANIZATION:arn:aws:organizations::123456789012:organization/o-a1b2c3d4e5")
+// @IDExample("port-0123456789abc:ACCOUNT", valid=false)
 func ResourcePortfolioShare() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePortfolioShareCreate,
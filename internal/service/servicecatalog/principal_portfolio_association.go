@@ -25,6 +25,9 @@ import (
 )
 
 // @SDKResource("aws_servicecatalog_principal_portfolio_association")
+// @IDHelpers(parse="PrincipalPortfolioAssociationParseResourceID", create="PrincipalPortfolioAssociationCreateResourceID")
+// @IDExample("en,arn:aws:iam::123456789012:role/my-role,port-0123456789abc,IAM")
+// @IDExample("en,arn:aws:iam::123456789012:role/my-role,port-0123456789abc", valid=false)
 func ResourcePrincipalPortfolioAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePrincipalPortfolioAssociationCreate,
@@ -20,6 +20,9 @@ import (
 )
 
 // @SDKResource("aws_servicecatalog_product_portfolio_association")
+// @IDHelpers(parse="ProductPortfolioAssociationParseID", create="ProductPortfolioAssociationCreateID")
+// @IDExample("en:port-0123456789abc:prod-0123456789abc")
+// @IDExample("en:port-0123456789abc", valid=false)
 func ResourceProductPortfolioAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProductPortfolioAssociationCreate,
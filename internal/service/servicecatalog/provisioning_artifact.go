@@ -23,6 +23,10 @@ import (
 )
 
 // @SDKResource("aws_servicecatalog_provisioning_artifact")
+// @IDHelpers(parse="ProvisioningArtifactParseID", create="ProvisioningArtifactID")
+// @IDExample("pa-0123456789abc:prod-0123456789abc")
+// @IDExample("pa-0123456789abc", valid=false)
+// @IDExample(":prod-0123456789abc", valid=false)
 func ResourceProvisioningArtifact() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceProvisioningArtifactCreate,
@@ -0,0 +1,233 @@
+// Code generated by internal/generate/idtests/main.go; DO NOT EDIT.
+
+package servicecatalog
+
+import (
+	"testing"
+)
+
+func TestBudgetResourceAssociationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"my-budget:port-0123456789abc": true,
+		"my-budget":                    false,
+		":port-0123456789abc":          false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := BudgetResourceAssociationParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("BudgetResourceAssociationParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("BudgetResourceAssociationParseID(%q): %s", id, err)
+			}
+
+			got := BudgetResourceAssociationID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("BudgetResourceAssociationID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestPortfolioShareResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"port-0123456789abc:ACCOUNT:123456789012":                                                       true,
+		"port-0123456789abc:ORGANIZATION:arn:aws:organizations::123456789012:organization/o-a1b2c3d4e5": true, //lintignore:AWSAT005
+		"port-0123456789abc:ACCOUNT":                                                                    false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := PortfolioShareParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("PortfolioShareParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("PortfolioShareParseResourceID(%q): %s", id, err)
+			}
+
+			got := PortfolioShareCreateResourceID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("PortfolioShareCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestPrincipalPortfolioAssociationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"en,arn:aws:iam::123456789012:role/my-role,port-0123456789abc,IAM": true,  //lintignore:AWSAT005
+		"en,arn:aws:iam::123456789012:role/my-role,port-0123456789abc":     false, //lintignore:AWSAT005
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, part3, err := PrincipalPortfolioAssociationParseResourceID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("PrincipalPortfolioAssociationParseResourceID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("PrincipalPortfolioAssociationParseResourceID(%q): %s", id, err)
+			}
+
+			got := PrincipalPortfolioAssociationCreateResourceID(part0, part1, part2, part3)
+
+			if want := id; got != want {
+				t.Errorf("PrincipalPortfolioAssociationCreateResourceID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestProductPortfolioAssociationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"en:port-0123456789abc:prod-0123456789abc": true,
+		"en:port-0123456789abc":                    false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, part2, err := ProductPortfolioAssociationParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ProductPortfolioAssociationParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ProductPortfolioAssociationParseID(%q): %s", id, err)
+			}
+
+			got := ProductPortfolioAssociationCreateID(part0, part1, part2)
+
+			if want := id; got != want {
+				t.Errorf("ProductPortfolioAssociationCreateID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestProvisioningArtifactResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"pa-0123456789abc:prod-0123456789abc": true,
+		"pa-0123456789abc":                    false,
+		":prod-0123456789abc":                 false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := ProvisioningArtifactParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("ProvisioningArtifactParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ProvisioningArtifactParseID(%q): %s", id, err)
+			}
+
+			got := ProvisioningArtifactID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("ProvisioningArtifactID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTagOptionResourceAssociationResourceIDExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"tag-0123456789abc:port-0123456789abc": true,
+		"tag-0123456789abc":                    false,
+		":port-0123456789abc":                  false,
+	}
+
+	for id, valid := range testCases {
+		id, valid := id, valid
+
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+
+			part0, part1, err := TagOptionResourceAssociationParseID(id)
+
+			if !valid {
+				if err == nil {
+					t.Fatalf("TagOptionResourceAssociationParseID(%q): expected error", id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("TagOptionResourceAssociationParseID(%q): %s", id, err)
+			}
+
+			got := TagOptionResourceAssociationID(part0, part1)
+
+			if want := id; got != want {
+				t.Errorf("TagOptionResourceAssociationID = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -21,6 +21,10 @@ import (
 )
 
 // @SDKResource("aws_servicecatalog_tag_option_resource_association")
+// @IDHelpers(parse="TagOptionResourceAssociationParseID", create="TagOptionResourceAssociationID")
+// @IDExample("tag-0123456789abc:port-0123456789abc")
+// @IDExample("tag-0123456789abc", valid=false)
+// @IDExample(":port-0123456789abc", valid=false)
 func ResourceTagOptionResourceAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTagOptionResourceAssociationCreate,
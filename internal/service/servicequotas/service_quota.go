@@ -23,6 +23,10 @@ import (
 )
 
 // @SDKResource("aws_servicequotas_service_quota")
+// @IDHelpers(parse="resourceServiceQuotaParseID")
+// @IDExample("vpc/L-F678F1CE")
+// @IDExample("vpc", valid=false)
+// @IDExample("/L-F678F1CE", valid=false)
 func ResourceServiceQuota() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceServiceQuotaCreate,
@@ -23,6 +23,10 @@ import (
 )
 
 // @SDKResource("aws_ses_identity_policy")
+// @IDHelpers(parse="IdentityPolicyParseID")
+// @IDExample("example.com|my-policy")
+// @IDExample("example.com", valid=false)
+// @IDExample("|my-policy", valid=false)
 func ResourceIdentityPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceIdentityPolicyCreate,
@@ -27,6 +27,9 @@ import (
 )
 
 // @SDKResource("aws_sesv2_configuration_set_event_destination")
+// @IDHelpers(parse="ParseConfigurationSetEventDestinationID")
+// @IDExample("my-configuration-set|my-event-destination")
+// @IDExample("my-configuration-set", valid=false)
 func ResourceConfigurationSetEventDestination() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceConfigurationSetEventDestinationCreate,
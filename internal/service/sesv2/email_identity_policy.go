@@ -27,6 +27,9 @@ import (
 )
 
 // @SDKResource("aws_sesv2_email_identity_policy", name="Email Identity Policy")
+// @IDHelpers(parse="ParseEmailIdentityPolicyID")
+// @IDExample("example.com|my-policy")
+// @IDExample("example.com", valid=false)
 func ResourceEmailIdentityPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEmailIdentityPolicyCreate,
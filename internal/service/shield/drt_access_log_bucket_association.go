@@ -29,6 +29,7 @@ import (
 )
 
 // @FrameworkResource(name="DRT Log Bucket Association")
+// @Region(global=true)
 func newDRTAccessLogBucketAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &drtAccessLogBucketAssociationResource{}
 
@@ -25,6 +25,7 @@ import (
 )
 
 // @FrameworkResource(name="DRT Role ARN Association")
+// @Region(global=true)
 func newDRTAccessRoleARNAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceDRTAccessRoleARNAssociation{}
 
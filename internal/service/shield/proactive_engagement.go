@@ -28,6 +28,7 @@ import (
 )
 
 // @FrameworkResource(name="Proactive Engagement")
+// @Region(global=true)
 func newProactiveEngagementResource(context.Context) (resource.ResourceWithConfigure, error) {
 	return &proactiveEngagementResource{}, nil
 }
@@ -19,6 +19,10 @@ import (
 )
 
 // @SDKResource("aws_shield_protection_health_check_association")
+// @Region(global=true)
+// @IDHelpers(parse="ProtectionHealthCheckAssociationParseResourceID", create="ProtectionHealthCheckAssociationCreateResourceID")
+// @IDExample("abcd1234-5678-90ab-cdef-1234567890ab+arn:aws:route53:::healthcheck/abcd1234-5678-90ab-cdef-1234567890ab")
+// @IDExample("abcd1234-5678-90ab-cdef-1234567890ab", valid=false)
 func ResourceProtectionHealthCheckAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: ResourceProtectionHealthCheckAssociationCreate,
@@ -104,6 +104,10 @@ var (
 )
 
 // @SDKResource("aws_sns_platform_application")
+// @IDHelpers(parse="parsePlatformApplicationResourceID")
+// @IDExample("arn:aws:sns:us-west-2:123456789012:app/GCM/my-application")
+// @IDExample("my-application", valid=false)
+// @IDExample("arn:aws:sns:us-west-2:123456789012:app/GCM", valid=false)
 func resourcePlatformApplication() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePlatformApplicationCreate,
@@ -48,6 +48,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Import: &types.ServicePackageResourceImport{
+				ARNService:  "sns",
+				ARNResource: "{name}",
+			},
 		},
 		{
 			Factory:  resourceTopicDataProtectionPolicy,
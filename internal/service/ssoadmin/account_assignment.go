@@ -29,6 +29,9 @@ import (
 )
 
 // @SDKResource("aws_ssoadmin_account_assignment")
+// @IDHelpers(parse="ParseAccountAssignmentID")
+// @IDExample("f81d4fae-7dec-11d0-a765-00a0c91e6bf6,USER,123456789012,AWS_ACCOUNT,arn:aws:sso:::permissionSet/ssoins-0123456789abcdef/ps-0123456789abcdef,arn:aws:sso:::instance/ssoins-0123456789abcdef")
+// @IDExample("f81d4fae-7dec-11d0-a765-00a0c91e6bf6,USER,123456789012,AWS_ACCOUNT,arn:aws:sso:::permissionSet/ssoins-0123456789abcdef/ps-0123456789abcdef", valid=false)
 func ResourceAccountAssignment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccountAssignmentCreate,
@@ -27,6 +27,9 @@ import (
 )
 
 // @SDKResource("aws_ssoadmin_customer_managed_policy_attachment")
+// @IDHelpers(parse="CustomerManagedPolicyAttachmentParseResourceID", create="CustomerManagedPolicyAttachmentCreateResourceID")
+// @IDExample("my-policy,/,arn:aws:sso:::permissionSet/ssoins-0123456789abcdef/ps-0123456789abcdef,arn:aws:sso:::instance/ssoins-0123456789abcdef")
+// @IDExample("my-policy,/,arn:aws:sso:::permissionSet/ssoins-0123456789abcdef/ps-0123456789abcdef", valid=false)
 func ResourceCustomerManagedPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCustomerManagedPolicyAttachmentCreate,
@@ -25,6 +25,9 @@ import (
 )
 
 // @SDKResource("aws_ssoadmin_managed_policy_attachment")
+// @IDHelpers(parse="ParseManagedPolicyAttachmentID")
+// @IDExample("arn:aws:iam::aws:policy/ReadOnlyAccess,arn:aws:sso:::permissionSet/ssoins-0123456789abcdef/ps-0123456789abcdef,arn:aws:sso:::instance/ssoins-0123456789abcdef")
+// @IDExample("arn:aws:iam::aws:policy/ReadOnlyAccess,arn:aws:sso:::permissionSet/ssoins-0123456789abcdef/ps-0123456789abcdef", valid=false)
 func ResourceManagedPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceManagedPolicyAttachmentCreate,
@@ -26,6 +26,9 @@ import (
 )
 
 // @SDKResource("aws_ssoadmin_permissions_boundary_attachment")
+// @IDHelpers(parse="PermissionsBoundaryAttachmentParseResourceID", create="PermissionsBoundaryAttachmentCreateResourceID")
+// @IDExample("arn:aws:sso:::permissionSet/ssoins-0123456789abcdef/ps-0123456789abcdef,arn:aws:sso:::instance/ssoins-0123456789abcdef")
+// @IDExample("arn:aws:sso:::permissionSet/ssoins-0123456789abcdef/ps-0123456789abcdef", valid=false)
 func ResourcePermissionsBoundaryAttachment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePermissionsBoundaryAttachmentCreate,
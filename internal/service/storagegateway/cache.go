@@ -21,6 +21,10 @@ import (
 )
 
 // @SDKResource("aws_storagegateway_cache", name="Cache")
+// @IDHelpers(parse="cacheParseResourceID", create="cacheCreateResourceID")
+// @IDExample("arn:aws:storagegateway:us-east-1:123456789012:gateway/sgw-12345678:pci-0000:03:00.0-scsi-0:0:0:0")
+// @IDExample("sgw-12345678:pci-0000", valid=false)
+// @IDExample("arn:aws:storagegateway:us-east-1:123456789012:gateway/sgw-12345678", valid=false)
 func resourceCache() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceCacheCreate,
@@ -22,6 +22,9 @@ import (
 )
 
 // @SDKResource("aws_synthetics_group_association", name="Group Association")
+// @IDHelpers(parse="GroupAssociationParseResourceID", create="GroupAssociationCreateResourceID")
+// @IDExample("arn:aws:synthetics:us-west-2:123456789012:canary:my-canary,my-group")
+// @IDExample("arn:aws:synthetics:us-west-2:123456789012:canary:my-canary", valid=false)
 func ResourceGroupAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupAssociationCreate,
@@ -29,6 +29,9 @@ import (
 
 // @SDKResource("aws_timestreamwrite_table", name="Table")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="tableParseResourceID", create="tableCreateResourceID")
+// @IDExample("my-table:my-database")
+// @IDExample("my-table", valid=false)
 func resourceTable() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTableCreate,
@@ -22,6 +22,9 @@ import (
 )
 
 // @SDKResource("aws_transfer_access")
+// @IDHelpers(parse="AccessParseResourceID", create="AccessCreateResourceID")
+// @IDExample("s-0123456789abcdef0/S-1-1-12-1234567890-123456789-1234567890-1234")
+// @IDExample("s-0123456789abcdef0", valid=false)
 func ResourceAccess() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccessCreate,
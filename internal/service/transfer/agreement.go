@@ -22,6 +22,9 @@ import (
 
 // @SDKResource("aws_transfer_agreement", name="Agreement")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="AgreementParseResourceID", create="AgreementCreateResourceID")
+// @IDExample("s-0123456789abcdef0/a-0123456789abcdef0")
+// @IDExample("s-0123456789abcdef0", valid=false)
 func ResourceAgreement() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAgreementCreate,
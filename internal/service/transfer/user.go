@@ -28,6 +28,9 @@ import (
 
 // @SDKResource("aws_transfer_user", name="User")
 // @Tags(identifierAttribute="arn")
+// @IDHelpers(parse="UserParseResourceID", create="UserCreateResourceID")
+// @IDExample("s-0123456789abcdef0/my-user")
+// @IDExample("s-0123456789abcdef0", valid=false)
 func ResourceUser() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserCreate,
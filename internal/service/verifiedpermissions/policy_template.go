@@ -29,6 +29,10 @@ import (
 )
 
 // @FrameworkResource(name="Policy Template")
+// @IDHelpers(parse="policyTemplateParseID")
+// @IDExample("PSEXAMPLEabcdefg111111:PTEXAMPLEabcdefg111111")
+// @IDExample("PSEXAMPLEabcdefg111111", valid=false)
+// @IDExample("PSEXAMPLEabcdefg111111:PTEXAMPLEabcdefg111111:extra", valid=false)
 func newResourcePolicyTemplate(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourcePolicyTemplate{}
 
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_waf_byte_match_set", name="ByteMatchSet")
+// @Region(global=true)
 func resourceByteMatchSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceByteMatchSetCreate,
@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_waf_ipset", name="IPSet")
+// @Region(global=true)
 func resourceIPSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceIPSetCreate,
@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_waf_regex_match_set", name="Regex Match Set")
+// @Region(global=true)
 func resourceRegexMatchSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRegexMatchSetCreate,
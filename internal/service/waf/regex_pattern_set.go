@@ -24,6 +24,7 @@ import (
 )
 
 // @SDKResource("aws_waf_regex_pattern_set", name="Regex Pattern Set")
+// @Region(global=true)
 func resourceRegexPatternSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRegexPatternSetCreate,
@@ -29,6 +29,7 @@ import (
 )
 
 // @SDKResource("aws_waf_rule", name="Rule")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceRule() *schema.Resource {
 	return &schema.Resource{
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_waf_rule", name="Rule")
+// @Region(global=true)
 func dataSourceRule() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceRuleRead,
@@ -23,6 +23,7 @@ import (
 )
 
 // @SDKResource("aws_waf_size_constraint_set", name="Size Constraint Set")
+// @Region(global=true)
 func resourceSizeConstraintSet() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceSizeConstraintSetCreate,
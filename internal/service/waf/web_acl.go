@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_waf_web_acl", name="Web ACL")
+// @Region(global=true)
 // @Tags(identifierAttribute="arn")
 func resourceWebACL() *schema.Resource {
 	return &schema.Resource{
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_waf_web_acl", name="Web ACL")
+// @Region(global=true)
 func dataSourceWebACL() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceWebACLRead,
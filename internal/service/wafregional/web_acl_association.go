@@ -25,6 +25,9 @@ import (
 )
 
 // @SDKResource("aws_wafregional_web_acl_association", name="Web ACL Association")
+// @IDHelpers(parse="webACLAssociationParseResourceID", create="webACLAssociationCreateResourceID")
+// @IDExample("12345678-1234-1234-1234-123456789012:arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188")
+// @IDExample("12345678-1234-1234-1234-123456789012", valid=false)
 func resourceWebACLAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceWebACLAssociationCreate,
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Description holds the metadata about a swept resource used for filtering and reporting.
+type Description struct {
+	ID        string
+	ARN       string
+	Tags      map[string]string
+	CreatedAt time.Time
+}
+
+// Describer is implemented by Sweepables that can describe the resource they sweep.
+type Describer interface {
+	Describe(ctx context.Context) (Description, error)
+}
+
+// Filter restricts the resources that are swept.
+type Filter struct {
+	// Tags holds the tags that a resource must have, with the same values.
+	Tags map[string]string
+	// OlderThan is the minimum age of a resource. Resources whose creation time is unknown do not match.
+	OlderThan time.Duration
+}
+
+// IsEmpty returns whether the filter matches all resources.
+func (f Filter) IsEmpty() bool {
+	return len(f.Tags) == 0 && f.OlderThan == 0
+}
+
+// Match returns whether the described resource matches the filter at the specified time.
+func (f Filter) Match(d Description, now time.Time) bool {
+	for k, v := range f.Tags {
+		if value, ok := d.Tags[k]; !ok || value != v {
+			return false
+		}
+	}
+
+	if f.OlderThan > 0 {
+		if d.CreatedAt.IsZero() || now.Sub(d.CreatedAt) < f.OlderThan {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseTags parses tag filters of the form "Key=Value".
+func ParseTags(tags []string) (map[string]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	m := make(map[string]string, len(tags))
+
+	for _, tag := range tags {
+		k, v, ok := strings.Cut(tag, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid tag filter (%s), expected Key=Value", tag)
+		}
+
+		m[k] = v
+	}
+
+	return m, nil
+}
+
+// creationTimeAttributes are the names of the attributes commonly used to hold a resource's creation time.
+var creationTimeAttributes = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+	"creation_timestamp",
+}
+
+// CreationTimeAttributes returns the names of the attributes that are checked for a resource's creation time.
+func CreationTimeAttributes() []string {
+	return creationTimeAttributes
+}
+
+// ParseCreationTime parses a creation time attribute value.
+func ParseCreationTime(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, time.RFC3339, time.DateTime} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		filter      Filter
+		description Description
+		expected    bool
+	}{
+		"empty filter": {
+			description: Description{ID: "id-1"},
+			expected:    true,
+		},
+		"tags match": {
+			filter: Filter{Tags: map[string]string{"CreatedBy": "ci"}},
+			description: Description{
+				Tags: map[string]string{"CreatedBy": "ci", "Name": "test"},
+			},
+			expected: true,
+		},
+		"tag value mismatch": {
+			filter: Filter{Tags: map[string]string{"CreatedBy": "ci"}},
+			description: Description{
+				Tags: map[string]string{"CreatedBy": "developer"},
+			},
+		},
+		"tag missing": {
+			filter:      Filter{Tags: map[string]string{"CreatedBy": "ci"}},
+			description: Description{},
+		},
+		"older": {
+			filter:      Filter{OlderThan: 6 * time.Hour},
+			description: Description{CreatedAt: now.Add(-7 * time.Hour)},
+			expected:    true,
+		},
+		"newer": {
+			filter:      Filter{OlderThan: 6 * time.Hour},
+			description: Description{CreatedAt: now.Add(-5 * time.Hour)},
+		},
+		"creation time unknown": {
+			filter:      Filter{OlderThan: 6 * time.Hour},
+			description: Description{},
+		},
+		"tags and age match": {
+			filter: Filter{
+				Tags:      map[string]string{"CreatedBy": "ci"},
+				OlderThan: 6 * time.Hour,
+			},
+			description: Description{
+				Tags:      map[string]string{"CreatedBy": "ci"},
+				CreatedAt: now.Add(-24 * time.Hour),
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.filter.Match(testCase.description, now), testCase.expected; got != want {
+				t.Errorf("Match = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       []string
+		expected    map[string]string
+		expectError bool
+	}{
+		"nil": {},
+		"single": {
+			input:    []string{"CreatedBy=ci"},
+			expected: map[string]string{"CreatedBy": "ci"},
+		},
+		"multiple": {
+			input:    []string{"CreatedBy=ci", "Environment=", "Expression=a=b"},
+			expected: map[string]string{"CreatedBy": "ci", "Environment": "", "Expression": "a=b"},
+		},
+		"no separator": {
+			input:       []string{"CreatedBy"},
+			expectError: true,
+		},
+		"empty key": {
+			input:       []string{"=ci"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseTags(testCase.input)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("ParseTags error = %v, expectError %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseCreationTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected time.Time
+		ok       bool
+	}{
+		"RFC3339": {
+			input:    "2024-06-01T12:00:00Z",
+			expected: time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		"RFC3339 with fraction": {
+			input:    "2024-06-01T12:00:00.5Z",
+			expected: time.Date(2024, time.June, 1, 12, 0, 0, 500000000, time.UTC),
+			ok:       true,
+		},
+		"invalid": {
+			input: "yesterday",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := ParseCreationTime(testCase.input)
+
+			if ok != testCase.ok {
+				t.Fatalf("ParseCreationTime ok = %t, want %t", ok, testCase.ok)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("ParseCreationTime = %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -13,13 +13,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -58,21 +62,12 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	metadata := resourceMetadata(ctx, resource)
 	ctx = tflog.SetField(ctx, "resource_type", metadata.TypeName)
 
-	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
-
-	schemaResp := fwresource.SchemaResponse{}
-	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
-
-	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
+	state, err := sr.state(ctx, resource)
+	if err != nil {
+		return err
 	}
 
 	for _, attr := range sr.attributes {
-		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
-		}
 		ctx = tflog.SetField(ctx, attr.path, attr.value)
 	}
 
@@ -118,6 +113,98 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe reads the resource and returns the metadata used to filter and report on sweeps.
+// An empty ID is returned if the resource no longer exists.
+func (sr *sweepResource) Describe(ctx context.Context) (filter.Description, error) {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return filter.Description{}, err
+	}
+
+	state, err := sr.state(ctx, resource)
+	if err != nil {
+		return filter.Description{}, err
+	}
+
+	ctx = tftags.NewContext(ctx, sr.meta.DefaultTagsConfig, sr.meta.IgnoreTagsConfig)
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if response.Diagnostics.HasError() {
+		return filter.Description{}, fwdiag.DiagnosticsError(response.Diagnostics)
+	}
+
+	var description filter.Description
+
+	if response.State.Raw.IsNull() {
+		return description, nil
+	}
+
+	description.ARN = stringAttribute(ctx, response.State, names.AttrARN)
+	description.ID = stringAttribute(ctx, response.State, names.AttrID)
+	if description.ID == "" {
+		description.ID = description.ARN
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok && inContext.TagsOut.IsSome() {
+		description.Tags = inContext.TagsOut.MustUnwrap().IgnoreAWS().Map()
+	} else {
+		for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+			var tags types.Map
+			if diags := response.State.GetAttribute(ctx, path.Root(k), &tags); diags.HasError() || tags.IsNull() || tags.IsUnknown() {
+				continue
+			}
+			if diags := tags.ElementsAs(ctx, &description.Tags, false); !diags.HasError() {
+				break
+			}
+		}
+	}
+
+	for _, k := range filter.CreationTimeAttributes() {
+		if t, ok := filter.ParseCreationTime(stringAttribute(ctx, response.State, k)); ok {
+			description.CreatedAt = t
+			break
+		}
+	}
+
+	return description, nil
+}
+
+// state returns the resource's initial state, with the sweeper's attributes set.
+func (sr *sweepResource) state(ctx context.Context, resource fwresource.ResourceWithConfigure) (tfsdk.State, error) {
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &fwresource.ConfigureResponse{})
+
+	schemaResp := fwresource.SchemaResponse{}
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+
+	for _, attr := range sr.attributes {
+		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
+		if d.HasError() {
+			return state, fwdiag.DiagnosticsError(d)
+		}
+	}
+
+	return state, nil
+}
+
+// stringAttribute returns the value of the specified top-level string attribute, or "" if there is no such attribute.
+func stringAttribute(ctx context.Context, state tfsdk.State, name string) string {
+	var v types.String
+
+	if diags := state.GetAttribute(ctx, path.Root(name), &v); diags.HasError() {
+		return ""
+	}
+
+	return v.ValueString()
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
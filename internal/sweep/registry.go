@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// Sweeper is a sweeper registered via Register.
+type Sweeper struct {
+	Name         string
+	F            SweeperFn
+	Dependencies []string
+}
+
+// registry holds all sweepers registered via Register, keyed by name.
+var registry = make(map[string]*Sweeper)
+
+// Sweepers returns all sweepers registered via Register, keyed by name.
+func Sweepers() map[string]*Sweeper {
+	return maps.Clone(registry)
+}
+
+// DependencyOrder returns the names of the specified sweepers, and of all the sweepers they transitively depend on,
+// in the order in which they must run. Each sweeper's dependencies run before it.
+// If no names are specified, all sweepers are returned.
+// Dependencies on sweepers that are not in the registry are included in the result.
+func DependencyOrder(sweepers map[string]*Sweeper, names ...string) ([]string, error) {
+	g := depgraph.New()
+
+	// Sort for a deterministic order.
+	sorted := tfmaps.Keys(sweepers)
+	slices.Sort(sorted)
+
+	for _, name := range sorted {
+		g.AddNode(name)
+	}
+
+	for _, name := range sorted {
+		for _, dependency := range sweepers[name].Dependencies {
+			g.AddNode(dependency)
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	order, err := g.OverallOrder()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return order, nil
+	}
+
+	selected := make(map[string]struct{})
+
+	for _, name := range names {
+		if _, ok := sweepers[name]; !ok {
+			return nil, fmt.Errorf("sweeper (%s) not found", name)
+		}
+
+		dependencies, err := g.DependenciesOf(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		selected[name] = struct{}{}
+		for _, dependency := range dependencies {
+			selected[dependency] = struct{}{}
+		}
+	}
+
+	return slices.DeleteFunc(order, func(name string) bool {
+		_, ok := selected[name]
+		return !ok
+	}), nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDependencyOrder(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*Sweeper{
+		"aws_vpc": {
+			Name:         "aws_vpc",
+			Dependencies: []string{"aws_subnet", "aws_internet_gateway"},
+		},
+		"aws_subnet": {
+			Name:         "aws_subnet",
+			Dependencies: []string{"aws_instance"},
+		},
+		"aws_internet_gateway": {
+			Name: "aws_internet_gateway",
+		},
+		"aws_instance": {
+			Name: "aws_instance",
+		},
+		"aws_xray_group": {
+			Name:         "aws_xray_group",
+			Dependencies: []string{"aws_legacy"},
+		},
+	}
+
+	testCases := map[string]struct {
+		names       []string
+		expected    []string
+		expectError bool
+	}{
+		"all": {
+			expected: []string{"aws_instance", "aws_subnet", "aws_internet_gateway", "aws_vpc", "aws_legacy", "aws_xray_group"},
+		},
+		"with dependencies": {
+			names:    []string{"aws_subnet"},
+			expected: []string{"aws_instance", "aws_subnet"},
+		},
+		"unregistered dependency": {
+			names:    []string{"aws_xray_group"},
+			expected: []string{"aws_legacy", "aws_xray_group"},
+		},
+		"multiple": {
+			names:    []string{"aws_internet_gateway", "aws_subnet"},
+			expected: []string{"aws_instance", "aws_subnet", "aws_internet_gateway"},
+		},
+		"not found": {
+			names:       []string{"aws_not_found"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DependencyOrder(sweepers, testCase.names...)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("DependencyOrder error = %v, expectError %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDependencyOrderCycle(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*Sweeper{
+		"a": {Name: "a", Dependencies: []string{"b"}},
+		"b": {Name: "b", Dependencies: []string{"a"}},
+	}
+
+	if _, err := DependencyOrder(sweepers); err == nil {
+		t.Fatal("expected error")
+	}
+}
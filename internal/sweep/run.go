@@ -0,0 +1,556 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+)
+
+// RunOptions configures a sweep run.
+type RunOptions struct {
+	// Regions are the AWS Regions to sweep.
+	Regions []string
+	// Sweepers are the names of the sweepers to run, together with their dependencies.
+	// All registered sweepers are run if none are specified.
+	Sweepers []string
+	// DryRun reports the resources that would be deleted without deleting them.
+	DryRun bool
+	// Filter restricts the resources that are deleted.
+	Filter filter.Filter
+	// Parallelism is the maximum number of sweepers that run concurrently in each Region.
+	// Sweepers run one at a time if not specified.
+	Parallelism int
+	// Timeout is the maximum time that each sweeper may run for. Sweepers are not timed out if not specified.
+	Timeout time.Duration
+	// Checkpoint is the file that progress is saved to. Sweepers that completed in a previous run
+	// using the same file are skipped. Progress is not saved if not specified, or in a dry run.
+	Checkpoint string
+}
+
+// Report records the resources that were, or in a dry run would be, deleted.
+type Report struct {
+	DryRun  bool                     `json:"dry_run"`
+	Regions map[string]*RegionReport `json:"regions"`
+	// Errors holds the errors that occurred in each sweeper across all Regions, keyed by sweeper name.
+	Errors map[string][]string `json:"errors,omitempty"`
+}
+
+// HasErrors returns whether any errors were recorded.
+func (r *Report) HasErrors() bool {
+	for _, region := range r.Regions {
+		if region.Error != "" {
+			return true
+		}
+
+		for _, sweeper := range region.Sweepers {
+			if sweeper.HasErrors() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type RegionReport struct {
+	Error    string           `json:"error,omitempty"`
+	Sweepers []*SweeperReport `json:"sweepers"`
+}
+
+type SweeperReport struct {
+	Name string `json:"name"`
+	// Skipped holds the reason that the sweeper did not run.
+	Skipped string `json:"skipped,omitempty"`
+	Error   string `json:"error,omitempty"`
+	// NotFilterable is the number of resources excluded because they can't be matched against the filter.
+	NotFilterable int               `json:"not_filterable,omitempty"`
+	Resources     []*ResourceReport `json:"resources,omitempty"`
+
+	// running is set if the sweeper timed out and may still be deleting resources.
+	running bool
+}
+
+// HasErrors returns whether any errors were recorded.
+func (r *SweeperReport) HasErrors() bool {
+	return r.Error != "" || tfslices.Any(r.Resources, func(v *ResourceReport) bool {
+		return v.Error != ""
+	})
+}
+
+type ResourceReport struct {
+	ID        string            `json:"id,omitempty"`
+	ARN       string            `json:"arn,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	CreatedAt *time.Time        `json:"created_at,omitempty"`
+	Deleted   bool              `json:"deleted"`
+	Error     string            `json:"error,omitempty"`
+}
+
+// Run runs the sweepers registered via Register in each of the specified Regions, in dependency order.
+// Regions are swept concurrently, as are sweepers in each Region that don't depend on one another.
+// Errors are recorded in the report and don't stop the run.
+func Run(ctx context.Context, options RunOptions) (*Report, error) {
+	order, err := DependencyOrder(registry, options.Sweepers...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint, err := loadCheckpoint(options.Checkpoint)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tfsdklog.RegisterStdlogSink(ctx)
+
+	report := &Report{
+		DryRun:  options.DryRun,
+		Regions: make(map[string]*RegionReport),
+	}
+
+	var wg sync.WaitGroup
+
+	for _, region := range options.Regions {
+		region := region
+		regionReport := &RegionReport{}
+		report.Regions[region] = regionReport
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx := logger(ctx, "sweeper", region)
+
+			client, err := SharedRegionalSweepClient(ctx, region)
+
+			if err != nil {
+				regionReport.Error = fmt.Sprintf("getting client: %s", err)
+				return
+			}
+
+			regionReport.Sweepers = runRegion(ctx, client, registry, order, region, options, checkpoint)
+		}()
+	}
+
+	wg.Wait()
+
+	report.aggregateErrors()
+
+	return report, nil
+}
+
+// aggregateErrors collects the errors recorded for each sweeper and resource across all Regions.
+func (r *Report) aggregateErrors() {
+	errs := make(map[string][]string)
+
+	// Sort for a deterministic order.
+	regions := tfmaps.Keys(r.Regions)
+	slices.Sort(regions)
+
+	for _, region := range regions {
+		for _, sweeper := range r.Regions[region].Sweepers {
+			if sweeper.Error != "" {
+				errs[sweeper.Name] = append(errs[sweeper.Name], fmt.Sprintf("%s: %s", region, sweeper.Error))
+			}
+
+			for _, resource := range sweeper.Resources {
+				if resource.Error != "" {
+					errs[sweeper.Name] = append(errs[sweeper.Name], fmt.Sprintf("%s: %s: %s", region, resource.ID, resource.Error))
+				}
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		r.Errors = errs
+	}
+}
+
+// runRegion runs the specified sweepers, which must be in dependency order, in a single Region.
+// Each sweeper starts once all of its dependencies have finished, subject to the configured parallelism.
+func runRegion(ctx context.Context, client *conns.AWSClient, sweepers map[string]*Sweeper, order []string, region string, options RunOptions, checkpoint *checkpoint) []*SweeperReport {
+	semaphore := make(chan struct{}, max(options.Parallelism, 1))
+	finished := make(map[string]chan struct{}, len(order))
+	indices := make(map[string]int, len(order))
+	for i, name := range order {
+		finished[name] = make(chan struct{})
+		indices[name] = i
+	}
+	reports := make([]*SweeperReport, len(order))
+
+	var wg sync.WaitGroup
+
+	for i, name := range order {
+		i, name := i, name
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(finished[name])
+
+			if sweeper, ok := sweepers[name]; ok {
+				for _, dependency := range sweeper.Dependencies {
+					if ch, ok := finished[dependency]; ok {
+						<-ch
+					}
+				}
+
+				// Don't delete resources that a sweeper which is still running may depend on.
+				for _, dependency := range sweeper.Dependencies {
+					if j, ok := indices[dependency]; ok && reports[j].running {
+						reports[i] = &SweeperReport{
+							Name:    name,
+							Skipped: fmt.Sprintf("dependency %s is still running", dependency),
+							running: true,
+						}
+						return
+					}
+				}
+			}
+
+			if checkpoint.isCompleted(region, name) {
+				reports[i] = &SweeperReport{
+					Name:    name,
+					Skipped: "completed in a previous run",
+				}
+				return
+			}
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			report := runSweeperWithTimeout(ctx, client, sweepers, name, options)
+			reports[i] = report
+
+			if options.DryRun || report.HasErrors() {
+				return
+			}
+
+			if err := checkpoint.complete(region, name); err != nil {
+				report.Error = fmt.Sprintf("saving checkpoint: %s", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return reports
+}
+
+// sweeperStopGracePeriod is how long a sweeper that has timed out is given to stop.
+var sweeperStopGracePeriod = time.Minute
+
+// runSweeperWithTimeout runs a single sweeper, cancelling it if it doesn't finish within the configured timeout.
+// A cancelled sweeper is waited for until it stops, up to a grace period, so that its dependents don't run
+// while it is still deleting resources.
+func runSweeperWithTimeout(ctx context.Context, client *conns.AWSClient, sweepers map[string]*Sweeper, name string, options RunOptions) *SweeperReport {
+	if options.Timeout <= 0 {
+		return runSweeper(ctx, client, sweepers, name, options)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, options.Timeout)
+	defer cancel()
+
+	ch := make(chan *SweeperReport, 1)
+	go func() {
+		ch <- runSweeper(ctx, client, sweepers, name, options)
+	}()
+
+	select {
+	case report := <-ch:
+		return report
+	case <-ctx.Done():
+	}
+
+	tflog.Warn(logWithResourceType(ctx, name), "Sweeper timed out", map[string]any{
+		"timeout": options.Timeout.String(),
+	})
+
+	select {
+	case report := <-ch:
+		// Keep the report on any resources deleted before the sweeper stopped.
+		report.Error = fmt.Sprintf("timed out after %s", options.Timeout)
+		return report
+	case <-time.After(sweeperStopGracePeriod):
+		tflog.Warn(logWithResourceType(ctx, name), "Sweeper did not stop", map[string]any{
+			"grace_period": sweeperStopGracePeriod.String(),
+		})
+
+		return &SweeperReport{
+			Name:    name,
+			Error:   fmt.Sprintf("timed out after %s and did not stop within %s", options.Timeout, sweeperStopGracePeriod),
+			running: true,
+		}
+	}
+}
+
+// runSweeper runs a single sweeper and reports on the resources that matched the filter.
+func runSweeper(ctx context.Context, client *conns.AWSClient, sweepers map[string]*Sweeper, name string, options RunOptions) *SweeperReport {
+	report := &SweeperReport{
+		Name: name,
+	}
+
+	sweeper, ok := sweepers[name]
+
+	if !ok {
+		report.Skipped = "not registered via sweep.Register"
+		return report
+	}
+
+	ctx = logWithResourceType(ctx, name)
+
+	sweepables, err := sweeper.F(ctx, client)
+
+	if SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		report.Skipped = err.Error()
+		return report
+	}
+
+	if err != nil {
+		report.Error = fmt.Sprintf("listing: %s", err)
+		return report
+	}
+
+	type candidate struct {
+		sweepable   Sweepable
+		description filter.Description
+		report      *ResourceReport
+	}
+	var candidates []*candidate
+
+	for _, sweepable := range sweepables {
+		describer, ok := sweepable.(filter.Describer)
+
+		if !ok {
+			if !options.Filter.IsEmpty() {
+				report.NotFilterable++
+				continue
+			}
+
+			candidates = append(candidates, &candidate{sweepable: sweepable, report: &ResourceReport{}})
+			continue
+		}
+
+		description, err := describer.Describe(ctx)
+
+		if err != nil {
+			report.Resources = append(report.Resources, &ResourceReport{
+				Error: fmt.Sprintf("describing: %s", err),
+			})
+			continue
+		}
+
+		// The resource has already been deleted.
+		if description.ID == "" {
+			continue
+		}
+
+		candidates = append(candidates, &candidate{sweepable: sweepable, description: description})
+	}
+
+	if len(options.Filter.Tags) > 0 {
+		var arns []string
+		for _, c := range candidates {
+			if c.description.Tags == nil && c.description.ARN != "" {
+				arns = append(arns, c.description.ARN)
+			}
+		}
+
+		tags, err := listTags(ctx, client, arns)
+
+		if err != nil {
+			report.Error = fmt.Sprintf("listing tags: %s", err)
+			return report
+		}
+
+		for _, c := range candidates {
+			if c.description.Tags == nil {
+				c.description.Tags = tags[c.description.ARN]
+			}
+		}
+	}
+
+	now := time.Now()
+	var matched []*candidate
+
+	for _, c := range candidates {
+		if c.report == nil {
+			if !options.Filter.Match(c.description, now) {
+				continue
+			}
+
+			c.report = &ResourceReport{
+				ID:   c.description.ID,
+				ARN:  c.description.ARN,
+				Tags: c.description.Tags,
+			}
+			if t := c.description.CreatedAt; !t.IsZero() {
+				c.report.CreatedAt = &t
+			}
+		}
+
+		report.Resources = append(report.Resources, c.report)
+		matched = append(matched, c)
+	}
+
+	if options.DryRun {
+		return report
+	}
+
+	var wg sync.WaitGroup
+
+	for _, c := range matched {
+		c := c
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := c.sweepable.Delete(ctx, ThrottlingRetryTimeout); err != nil {
+				c.report.Error = err.Error()
+				return
+			}
+
+			c.report.Deleted = true
+		}()
+	}
+
+	wg.Wait()
+
+	return report
+}
+
+// listTags returns the tags of the specified resources, keyed by ARN.
+func listTags(ctx context.Context, client *conns.AWSClient, arns []string) (map[string]map[string]string, error) {
+	tags := make(map[string]map[string]string)
+
+	if len(arns) == 0 {
+		return tags, nil
+	}
+
+	conn := client.ResourceGroupsTaggingAPIClient(ctx)
+
+	// GetResources accepts at most 100 ARNs.
+	for _, chunk := range tfslices.Chunks(arns, 100) {
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: chunk,
+		}
+
+		pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.ResourceTagMappingList {
+				m := make(map[string]string)
+				for _, tag := range v.Tags {
+					m[aws_sdkv2.ToString(tag.Key)] = aws_sdkv2.ToString(tag.Value)
+				}
+				tags[aws_sdkv2.ToString(v.ResourceARN)] = m
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// checkpoint records the sweepers that have completed in each Region so that an interrupted run can be resumed.
+type checkpoint struct {
+	Completed map[string][]string `json:"completed"`
+
+	filename string
+	mutex    sync.Mutex
+}
+
+// loadCheckpoint reads the checkpoint from the specified file.
+// An empty checkpoint is returned if no file is specified or the file doesn't exist.
+func loadCheckpoint(filename string) (*checkpoint, error) {
+	c := &checkpoint{
+		Completed: make(map[string][]string),
+		filename:  filename,
+	}
+
+	if filename == "" {
+		return c, nil
+	}
+
+	b, err := os.ReadFile(filename)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return c, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading checkpoint (%s): %w", filename, err)
+	}
+
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("reading checkpoint (%s): %w", filename, err)
+	}
+
+	if c.Completed == nil {
+		c.Completed = make(map[string][]string)
+	}
+
+	return c, nil
+}
+
+// isCompleted returns whether the specified sweeper has completed in the specified Region.
+func (c *checkpoint) isCompleted(region, name string) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return slices.Contains(c.Completed[region], name)
+}
+
+// complete records that the specified sweeper has completed in the specified Region and saves the checkpoint.
+func (c *checkpoint) complete(region, name string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.Completed[region] = append(c.Completed[region], name)
+
+	if c.filename == "" {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(c, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename so that an interrupted write doesn't corrupt the checkpoint.
+	tmp := c.filename + ".tmp"
+
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, c.filename)
+}
@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type mockSweepable struct {
+	description filter.Description
+	deleteErr   error
+	deleted     bool
+}
+
+func (m *mockSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	if m.deleteErr != nil {
+		return m.deleteErr
+	}
+
+	m.deleted = true
+
+	return nil
+}
+
+func (m *mockSweepable) Describe(context.Context) (filter.Description, error) {
+	return m.description, nil
+}
+
+type mockOpaqueSweepable struct {
+	deleted bool
+}
+
+func (m *mockOpaqueSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	m.deleted = true
+
+	return nil
+}
+
+func TestRunSweeper(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	old := time.Now().Add(-24 * time.Hour)
+	recent := time.Now().Add(-1 * time.Hour)
+
+	testCases := map[string]struct {
+		options             RunOptions
+		expectedIDs         []string
+		expectedDeleted     int
+		expectNotFilterable int
+		expectError         bool
+	}{
+		"no filter": {
+			expectedIDs:     []string{"ci-old", "ci-recent", "dev-old", "failing", ""},
+			expectedDeleted: 4,
+			expectError:     true,
+		},
+		"dry run": {
+			options:     RunOptions{DryRun: true},
+			expectedIDs: []string{"ci-old", "ci-recent", "dev-old", "failing", ""},
+		},
+		"tag filter": {
+			options: RunOptions{
+				DryRun: true,
+				Filter: filter.Filter{Tags: map[string]string{"CreatedBy": "ci"}},
+			},
+			expectedIDs:         []string{"ci-old", "ci-recent"},
+			expectNotFilterable: 1,
+		},
+		"tag and age filter": {
+			options: RunOptions{
+				Filter: filter.Filter{
+					Tags:      map[string]string{"CreatedBy": "ci"},
+					OlderThan: 6 * time.Hour,
+				},
+			},
+			expectedIDs:         []string{"ci-old"},
+			expectedDeleted:     1,
+			expectNotFilterable: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			sweepables := []Sweepable{
+				&mockSweepable{description: filter.Description{ID: "ci-old", Tags: map[string]string{"CreatedBy": "ci"}, CreatedAt: old}},
+				&mockSweepable{description: filter.Description{ID: "ci-recent", Tags: map[string]string{"CreatedBy": "ci"}, CreatedAt: recent}},
+				&mockSweepable{description: filter.Description{ID: "dev-old", Tags: map[string]string{"CreatedBy": "dev"}, CreatedAt: old}},
+				&mockSweepable{description: filter.Description{ID: "failing", Tags: map[string]string{"CreatedBy": "dev"}}, deleteErr: errors.New("failed")},
+				&mockSweepable{description: filter.Description{ID: ""}},
+				&mockOpaqueSweepable{},
+			}
+			sweepers := map[string]*Sweeper{
+				"aws_test": {
+					Name: "aws_test",
+					F: func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+						return sweepables, nil
+					},
+				},
+			}
+
+			report := runSweeper(ctx, nil, sweepers, "aws_test", testCase.options)
+
+			var ids []string
+			var deleted int
+			for _, v := range report.Resources {
+				ids = append(ids, v.ID)
+				if v.Deleted {
+					deleted++
+				}
+			}
+
+			if got, want := len(ids), len(testCase.expectedIDs); got != want {
+				t.Fatalf("resources = %v, want %v", ids, testCase.expectedIDs)
+			}
+			for i, id := range testCase.expectedIDs {
+				if ids[i] != id {
+					t.Errorf("resources = %v, want %v", ids, testCase.expectedIDs)
+				}
+			}
+
+			if got, want := deleted, testCase.expectedDeleted; got != want {
+				t.Errorf("deleted = %d, want %d", got, want)
+			}
+
+			if got, want := report.NotFilterable, testCase.expectNotFilterable; got != want {
+				t.Errorf("NotFilterable = %d, want %d", got, want)
+			}
+
+			if got, want := report.HasErrors(), testCase.expectError; got != want {
+				t.Errorf("HasErrors = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRunSweeperNotRegistered(t *testing.T) {
+	t.Parallel()
+
+	report := runSweeper(context.Background(), nil, map[string]*Sweeper{}, "aws_legacy", RunOptions{})
+
+	if report.Skipped == "" {
+		t.Error("expected sweeper to be skipped")
+	}
+}
+
+func TestRunRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var mutex sync.Mutex
+	var finished []string
+	sweeperFn := func(name string, d time.Duration) SweeperFn {
+		return func(ctx context.Context, _ *conns.AWSClient) ([]Sweepable, error) {
+			select {
+			case <-time.After(d):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			finished = append(finished, name)
+
+			return nil, nil
+		}
+	}
+
+	sweepers := map[string]*Sweeper{
+		"aws_vpc": {
+			Name:         "aws_vpc",
+			F:            sweeperFn("aws_vpc", 0),
+			Dependencies: []string{"aws_subnet"},
+		},
+		"aws_subnet": {
+			Name: "aws_subnet",
+			F:    sweeperFn("aws_subnet", 50*time.Millisecond),
+		},
+		"aws_stuck": {
+			Name: "aws_stuck",
+			F:    sweeperFn("aws_stuck", time.Hour),
+		},
+	}
+	order, err := DependencyOrder(sweepers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filename := filepath.Join(t.TempDir(), "checkpoint.json")
+	checkpoint, err := loadCheckpoint(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	options := RunOptions{
+		Parallelism: 2,
+		Timeout:     time.Second,
+	}
+	reports := runRegion(ctx, nil, sweepers, order, "us-west-2", options, checkpoint)
+
+	if got, want := finished, []string{"aws_subnet", "aws_vpc"}; !slices.Equal(got, want) {
+		t.Errorf("finished = %v, want %v", got, want)
+	}
+
+	errs := make(map[string]string)
+	for _, report := range reports {
+		errs[report.Name] = report.Error
+	}
+	if errs["aws_stuck"] == "" {
+		t.Error("expected aws_stuck to time out")
+	}
+	if errs["aws_subnet"] != "" || errs["aws_vpc"] != "" {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+
+	// Resume from the checkpoint. Only the sweeper that timed out runs again.
+	checkpoint, err = loadCheckpoint(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"aws_subnet", "aws_vpc"} {
+		if !checkpoint.isCompleted("us-west-2", name) {
+			t.Errorf("expected %s to be completed", name)
+		}
+	}
+	if checkpoint.isCompleted("us-west-2", "aws_stuck") {
+		t.Error("expected aws_stuck not to be completed")
+	}
+	if checkpoint.isCompleted("us-east-1", "aws_vpc") {
+		t.Error("expected aws_vpc not to be completed in us-east-1")
+	}
+
+	sweepers["aws_stuck"].F = sweeperFn("aws_stuck", 0)
+	finished = nil
+	reports = runRegion(ctx, nil, sweepers, order, "us-west-2", options, checkpoint)
+
+	if got, want := finished, []string{"aws_stuck"}; !slices.Equal(got, want) {
+		t.Errorf("finished = %v, want %v", got, want)
+	}
+	for _, report := range reports {
+		if report.Name != "aws_stuck" && report.Skipped == "" {
+			t.Errorf("expected %s to be skipped", report.Name)
+		}
+	}
+}
+
+func TestRunRegionSweeperDoesNotStop(t *testing.T) { //nolint:paralleltest
+	defer func(d time.Duration) { sweeperStopGracePeriod = d }(sweeperStopGracePeriod)
+	sweeperStopGracePeriod = 50 * time.Millisecond
+
+	ctx := context.Background()
+
+	// The sweeper ignores cancellation until the test has finished.
+	stop := make(chan struct{})
+	defer close(stop)
+
+	var vpcRan bool
+	sweepers := map[string]*Sweeper{
+		"aws_vpc": {
+			Name: "aws_vpc",
+			F: func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+				vpcRan = true
+				return nil, nil
+			},
+			Dependencies: []string{"aws_subnet"},
+		},
+		"aws_subnet": {
+			Name: "aws_subnet",
+			F: func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+				<-stop
+				return nil, nil
+			},
+		},
+	}
+	order, err := DependencyOrder(sweepers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkpoint, err := loadCheckpoint(filepath.Join(t.TempDir(), "checkpoint.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	options := RunOptions{
+		Parallelism: 2,
+		Timeout:     50 * time.Millisecond,
+	}
+	reports := runRegion(ctx, nil, sweepers, order, "us-west-2", options, checkpoint)
+
+	if vpcRan {
+		t.Error("expected aws_vpc not to run")
+	}
+
+	for _, report := range reports {
+		switch report.Name {
+		case "aws_subnet":
+			if report.Error == "" {
+				t.Error("expected aws_subnet to time out")
+			}
+		case "aws_vpc":
+			if report.Skipped == "" {
+				t.Error("expected aws_vpc to be skipped")
+			}
+		}
+
+		if checkpoint.isCompleted("us-west-2", report.Name) {
+			t.Errorf("expected %s not to be completed", report.Name)
+		}
+	}
+}
+
+func TestReportAggregateErrors(t *testing.T) {
+	t.Parallel()
+
+	report := &Report{
+		Regions: map[string]*RegionReport{
+			"us-west-2": {
+				Sweepers: []*SweeperReport{
+					{Name: "aws_vpc", Resources: []*ResourceReport{{ID: "vpc-1", Error: "DependencyViolation"}, {ID: "vpc-2", Deleted: true}}},
+					{Name: "aws_subnet"},
+				},
+			},
+			"us-east-1": {
+				Sweepers: []*SweeperReport{
+					{Name: "aws_vpc", Error: "timed out after 1s"},
+				},
+			},
+		},
+	}
+
+	report.aggregateErrors()
+
+	want := map[string][]string{
+		"aws_vpc": {
+			"us-east-1: timed out after 1s",
+			"us-west-2: vpc-1: DependencyViolation",
+		},
+	}
+	if diff := cmp.Diff(report.Errors, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
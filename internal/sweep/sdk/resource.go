@@ -16,8 +16,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -89,6 +93,53 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe reads the resource and returns the metadata used to filter and report on sweeps.
+// An empty ID is returned if the resource no longer exists.
+func (sr *sweepResource) Describe(ctx context.Context) (filter.Description, error) {
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+	ctx = tftags.NewContext(ctx, sr.meta.DefaultTagsConfig, sr.meta.IgnoreTagsConfig)
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return filter.Description{}, err
+	}
+
+	description := filter.Description{
+		ID: sr.d.Id(),
+	}
+
+	if description.ID == "" {
+		return description, nil
+	}
+
+	schemaMap := sr.resource.SchemaMap()
+
+	if v, ok := schemaMap[names.AttrARN]; ok && v.Type == schema.TypeString {
+		description.ARN = sr.d.Get(names.AttrARN).(string)
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok && inContext.TagsOut.IsSome() {
+		description.Tags = inContext.TagsOut.MustUnwrap().IgnoreAWS().Map()
+	} else {
+		for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+			if v, ok := schemaMap[k]; ok && v.Type == schema.TypeMap {
+				description.Tags = flex.ExpandStringValueMap(sr.d.Get(k).(map[string]any))
+				break
+			}
+		}
+	}
+
+	for _, k := range filter.CreationTimeAttributes() {
+		if v, ok := schemaMap[k]; ok && v.Type == schema.TypeString {
+			if t, ok := filter.ParseCreationTime(sr.d.Get(k).(string)); ok {
+				description.CreatedAt = t
+				break
+			}
+		}
+	}
+
+	return description, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
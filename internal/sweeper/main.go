@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+)
+
+type stringSliceFlag []string
+
+func (v *stringSliceFlag) String() string {
+	return strings.Join(*v, ",")
+}
+
+func (v *stringSliceFlag) Set(s string) error {
+	*v = append(*v, s)
+	return nil
+}
+
+var (
+	all         = flag.Bool("all", false, "allow -delete to delete resources without a -tag or -older-than filter")
+	checkpoint  = flag.String("checkpoint", "", "file to save progress to, and to resume from if it exists")
+	del         = flag.Bool("delete", false, "delete resources (default only report the resources that would be deleted)")
+	olderThan   = flag.Duration("older-than", 0, "only delete resources created more than this long ago, e.g. 6h")
+	parallelism = flag.Int("parallelism", 10, "maximum number of sweepers to run concurrently in each AWS Region")
+	regions     = flag.String("regions", "us-west-2,us-east-1", "comma-separated list of AWS Regions to sweep")
+	report      = flag.String("report", "", "file to write the JSON report to (default standard output)")
+	sweepers    = flag.String("sweepers", "", "comma-separated list of sweepers to run, together with their dependencies (default all)")
+	tags        stringSliceFlag
+	timeout     = flag.Duration("timeout", 30*time.Minute, "maximum time each sweeper may run for (0 for no timeout)")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tsweeper [-delete [-all]] [-regions <regions>] [-sweepers <sweepers>] [-tag <key>=<value>]... [-older-than <duration>] [-parallelism <n>] [-timeout <duration>] [-checkpoint <file>] [-report <file>]\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Var(&tags, "tag", "only delete resources with this tag, as Key=Value (can be repeated)")
+	flag.Usage = usage
+	flag.Parse()
+
+	if err := run(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	tagFilter, err := filter.ParseTags(tags)
+
+	if err != nil {
+		return err
+	}
+
+	options := sweep.RunOptions{
+		Regions: splitList(*regions),
+		DryRun:  !*del,
+		Filter: filter.Filter{
+			Tags:      tagFilter,
+			OlderThan: *olderThan,
+		},
+		Sweepers:    splitList(*sweepers),
+		Parallelism: *parallelism,
+		Timeout:     *timeout,
+		Checkpoint:  *checkpoint,
+	}
+
+	if len(options.Regions) == 0 {
+		return fmt.Errorf("at least one AWS Region is required")
+	}
+
+	// Deleting every sweepable resource in an account must be asked for explicitly.
+	if !options.DryRun && len(options.Filter.Tags) == 0 && options.Filter.OlderThan == 0 && !*all {
+		return fmt.Errorf("-delete requires a -tag or -older-than filter, or -all to delete all resources")
+	}
+
+	sweep.ServicePackages = servicePackages(ctx)
+
+	registerSweepers()
+
+	result, err := sweep.Run(ctx, options)
+
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(result, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("encoding report: %w", err)
+	}
+
+	if *report == "" {
+		if _, err := fmt.Fprintln(os.Stdout, string(b)); err != nil {
+			return fmt.Errorf("writing report: %w", err)
+		}
+	} else if err := os.WriteFile(*report, b, 0600); err != nil {
+		return fmt.Errorf("writing report (%s): %w", *report, err)
+	}
+
+	if result.HasErrors() {
+		return fmt.Errorf("errors occurred while sweeping, see the report for details")
+	}
+
+	return nil
+}
+
+func splitList(s string) []string {
+	var list []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
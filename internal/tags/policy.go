@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	PolicyModeError = "error"
+	PolicyModeWarn  = "warn"
+)
+
+func PolicyMode_Values() []string {
+	return []string{
+		PolicyModeError,
+		PolicyModeWarn,
+	}
+}
+
+// PolicyConfig contains constraints that resource tags, including any default tags, must satisfy.
+type PolicyConfig struct {
+	// Mode is either PolicyModeError or PolicyModeWarn.
+	Mode         string
+	RequiredKeys []string
+	// Rules constrain the values of tags, keyed by tag key.
+	// A rule applies only if the resource has a tag with that key.
+	Rules map[string]PolicyRule
+}
+
+// PolicyRule constrains the value of a tag.
+type PolicyRule struct {
+	AllowedValues []string
+	ValuePattern  *regexp.Regexp
+}
+
+// IsWarn returns whether policy violations should be reported as warnings rather than errors.
+func (pc *PolicyConfig) IsWarn() bool {
+	return pc != nil && pc.Mode == PolicyModeWarn
+}
+
+// Validate returns an error describing each way in which the specified tags don't comply with the policy.
+func (pc *PolicyConfig) Validate(tags KeyValueTags) error {
+	if pc == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, key := range pc.MissingKeys(tags) {
+		errs = append(errs, fmt.Errorf("required tag %q is missing", key))
+	}
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		rule, ok := pc.Rules[key]
+		if !ok {
+			continue
+		}
+
+		var value string
+		if v := tags.KeyValue(key); v != nil {
+			value = *v
+		}
+
+		if len(rule.AllowedValues) > 0 && !slices.Contains(rule.AllowedValues, value) {
+			errs = append(errs, fmt.Errorf("tag %q value %q is not one of [%s]", key, value, strings.Join(rule.AllowedValues, ", ")))
+		}
+
+		if rule.ValuePattern != nil && !rule.ValuePattern.MatchString(value) {
+			errs = append(errs, fmt.Errorf("tag %q value %q does not match %q", key, value, rule.ValuePattern.String()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// MissingKeys returns the required tag keys that are not present in the specified tags.
+func (pc *PolicyConfig) MissingKeys(tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	var keys []string
+
+	for _, key := range pc.RequiredKeys {
+		if !tags.KeyExists(key) {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
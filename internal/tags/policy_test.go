@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policyConfig := &PolicyConfig{
+		Mode:         PolicyModeError,
+		RequiredKeys: []string{"Owner", "CostCenter"},
+		Rules: map[string]PolicyRule{
+			"Environment": {
+				AllowedValues: []string{"dev", "prod"},
+			},
+			"CostCenter": {
+				ValuePattern: regexache.MustCompile(`^CC-[0-9]{4}$`),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		tags         KeyValueTags
+		wantErr      string
+		wantMissing  []string
+	}{
+		{
+			name:         "nil config",
+			policyConfig: nil,
+			tags:         New(ctx, map[string]string{}),
+		},
+		{
+			name:         "compliant",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "CC-1234",
+				"Environment": "prod",
+				"Owner":       "team",
+			}),
+		},
+		{
+			name:         "constrained key absent",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "CC-1234",
+				"Owner":      "team",
+			}),
+		},
+		{
+			name:         "missing keys",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"Environment": "dev",
+			}),
+			wantErr:     "required tag \"CostCenter\" is missing\nrequired tag \"Owner\" is missing",
+			wantMissing: []string{"CostCenter", "Owner"},
+		},
+		{
+			name:         "invalid values",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "test",
+				"Owner":       "team",
+			}),
+			wantErr: "tag \"CostCenter\" value \"1234\" does not match \"^CC-[0-9]{4}$\"\ntag \"Environment\" value \"test\" is not one of [dev, prod]",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotErr string
+			if err := testCase.policyConfig.Validate(testCase.tags); err != nil {
+				gotErr = err.Error()
+			}
+
+			if diff := cmp.Diff(gotErr, testCase.wantErr); diff != "" {
+				t.Errorf("unexpected error diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.policyConfig.MissingKeys(testCase.tags), testCase.wantMissing); diff != "" {
+				t.Errorf("unexpected missing keys diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
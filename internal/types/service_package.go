@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,20 +18,52 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceRegion represents resource-level Region information.
+type ServicePackageResourceRegion struct {
+	IsGlobal          bool // Is the resource global?
+	IsOverrideEnabled bool // Does the resource support per-resource Region override?
+}
+
+// ServicePackageResourceImport represents resource-level import information.
+type ServicePackageResourceImport struct {
+	ARNService  string // The service namespace in the resource's ARN, e.g. "iam"
+	ARNResource string // Pattern matching the resource part of the resource's ARN, e.g. "role/*{name}"
+	ID          string // Import ID format, e.g. "{name}". The ARN is the import ID if empty
+}
+
+// ServicePackageResourceIdentity represents resource-level identity information.
+type ServicePackageResourceIdentity struct {
+	IDAttributes []string // The attributes whose values make up the resource's ID, in order
+	IDSeparator  string   // The separator between the parts of a multi-part ID
+	ARNFormat    string   // Template for the resource's ARN, e.g. "arn:{partition}:dynamodb:{region}:{account}:table/{name}"
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
 	Factory func(context.Context) (datasource.DataSourceWithConfigure, error)
 	Name    string
 	Tags    *ServicePackageResourceTags
+	Region  *ServicePackageResourceRegion
+}
+
+// ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
+// implemented by a service package.
+type ServicePackageEphemeralResource struct {
+	Factory func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
+	Name    string
 }
 
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
+	Name     string
+	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
+	Import   *ServicePackageResourceImport
+	Identity *ServicePackageResourceIdentity
+	Stateful bool // Does the resource hold data that is lost if it is replaced or deleted?
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -40,6 +73,7 @@ type ServicePackageSDKDataSource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
@@ -49,4 +83,8 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Region   *ServicePackageResourceRegion
+	Import   *ServicePackageResourceImport
+	Identity *ServicePackageResourceIdentity
+	Stateful bool // Does the resource hold data that is lost if it is replaced or deleted?
 }
@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -16,7 +17,7 @@ func main() {
 	debugFlag := flag.Bool("debug", false, "Start provider in debug mode.")
 	flag.Parse()
 
-	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(context.Background())
+	serverFactory, p, err := provider.ProtoV5ProviderServerFactory(context.Background())
 
 	if err != nil {
 		log.Fatal(err)
@@ -38,6 +39,13 @@ func main() {
 		serveOpts...,
 	)
 
+	// The provider has stopped. Release resources held by the configured provider, e.g. the audit log file.
+	if v, ok := p.Meta().(*conns.AWSClient); ok {
+		if err := v.Close(); err != nil {
+			log.Printf("[WARN] closing provider: %s", err)
+		}
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -4,6 +4,8 @@
 package cmd
 
 import (
+	"errors"
+
 	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
 	"github.com/spf13/cobra"
 )
@@ -16,12 +18,21 @@ var (
 	v1            bool
 	pluginSDKV2   bool
 	includeTags   bool
+	operations    resource.Operations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if operations != (resource.Operations{}) {
+			if v1 || pluginSDKV2 {
+				return errors.New("resources generated from AWS SDK operations use AWS SDK for Go v2 and Terraform Plugin Framework")
+			}
+
+			return resource.CreateFromSDK(name, snakeName, operations, !clearComments, force)
+		}
+
 		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags)
 	},
 }
@@ -35,4 +46,9 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&operations.Create, "create-op", "", "AWS SDK for Go v2 operation that creates the resource (e.g., CreateThing); generates a fully wired resource")
+	resourceCmd.Flags().StringVar(&operations.Read, "read-op", "", "AWS SDK for Go v2 operation that describes the resource (e.g., DescribeThing)")
+	resourceCmd.Flags().StringVar(&operations.Update, "update-op", "", "AWS SDK for Go v2 operation that updates the resource (e.g., UpdateThing)")
+	resourceCmd.Flags().StringVar(&operations.Delete, "delete-op", "", "AWS SDK for Go v2 operation that deletes the resource (e.g., DeleteThing)")
+	resourceCmd.Flags().StringVar(&operations.List, "list-op", "", "AWS SDK for Go v2 operation that lists resources (e.g., ListThings); generates a plural data source and sweeper")
 }
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/service"
+	"github.com/spf13/cobra"
+)
+
+var (
+	sdkID             string
+	humanFriendly     string
+	brand             string
+	cliCommand        string
+	goV2Package       string
+	providerNameUpper string
+	endpointAPICall   string
+	skipGenerate      bool
+)
+
+var serviceCmd = &cobra.Command{
+	Use:   "service",
+	Short: "Create scaffolding for a service",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return service.Create(service.Options{
+			Name:              name,
+			SDKID:             sdkID,
+			HumanFriendly:     humanFriendly,
+			Brand:             brand,
+			CLICommand:        cliCommand,
+			GoV2Package:       goV2Package,
+			ProviderNameUpper: providerNameUpper,
+			EndpointAPICall:   endpointAPICall,
+			IncludeComments:   !clearComments,
+			Force:             force,
+			Generate:          !skipGenerate,
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serviceCmd)
+	serviceCmd.Flags().StringVarP(&name, "name", "n", "", "name of the service package (e.g., costoptimizationhub)")
+	serviceCmd.Flags().StringVarP(&sdkID, "sdk-id", "i", "", "service ID from the AWS SDK for Go v2 (e.g., Cost Optimization Hub)")
+	serviceCmd.Flags().StringVar(&humanFriendly, "human-friendly", "", "human-friendly service name, without brand (defaults to the SDK ID)")
+	serviceCmd.Flags().StringVar(&brand, "brand", "AWS", "service brand, AWS or Amazon")
+	serviceCmd.Flags().StringVar(&cliCommand, "cli-command", "", "AWS CLI v2 command (defaults to the lower-cased SDK ID with dashes)")
+	serviceCmd.Flags().StringVar(&goV2Package, "go-package", "", "AWS SDK for Go v2 package name (defaults to the service package name)")
+	serviceCmd.Flags().StringVar(&providerNameUpper, "provider-name-upper", "", "capitalized service name used in code (defaults to the SDK ID without spaces)")
+	serviceCmd.Flags().StringVar(&endpointAPICall, "endpoint-api-call", "", "API operation called by the generated endpoints test (defaults to the first List operation without required parameters)")
+	serviceCmd.Flags().BoolVar(&skipGenerate, "skip-generate", false, "only update names data and create the service package files; do not fetch the AWS SDK module or run code generators")
+	serviceCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "do not include instructional comments in source")
+	serviceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+}
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdk"
+)
+
+// maxNestingDepth limits how deeply nested SDK structures are turned into nested models.
+const maxNestingDepth = 5
+
+// attribute is a schema attribute or block, and the corresponding model field,
+// derived from an SDK structure member.
+type attribute struct {
+	Member        string // SDK member name, e.g. ModelArn.
+	Name          string // Terraform attribute name, e.g. model_arn.
+	NameExpr      string // Name as used in the schema, e.g. names.AttrID or "model_arn".
+	FieldName     string // Model field name, e.g. ModelARN.
+	FieldType     string // Model field type, e.g. fwtypes.ARN.
+	Definition    string // Predefined schema attribute, e.g. framework.ARNAttributeComputedOnly().
+	Schema        string // Schema attribute or block type, e.g. schema.StringAttribute.
+	CustomType    string
+	ElementType   string
+	Required      bool
+	Optional      bool
+	Computed      bool
+	PlanModifier  string // Plan modifier element type, e.g. String.
+	PlanModifiers []string
+	Validator     string // Validator element type, e.g. List.
+	Validators    []string
+	Block         bool
+	Nested        *model
+}
+
+// model is a Terraform Plugin Framework model struct.
+type model struct {
+	Name        string
+	Fields      []*attribute
+	Attributes  []*attribute
+	Blocks      []*attribute
+	Unsupported []string
+}
+
+func (m *model) add(attr *attribute) {
+	m.Fields = append(m.Fields, attr)
+
+	if attr.Block {
+		m.Blocks = append(m.Blocks, attr)
+	} else {
+		m.Attributes = append(m.Attributes, attr)
+	}
+}
+
+func (m *model) sort() {
+	for _, v := range [][]*attribute{m.Fields, m.Attributes, m.Blocks} {
+		slices.SortFunc(v, func(a, b *attribute) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+	}
+}
+
+// modelBuilder derives models from SDK structures.
+type modelBuilder struct {
+	pkg    *sdk.Package
+	taken  map[string]bool   // Type names already declared in the package.
+	nested map[string]*model // Nested models by SDK structure name.
+	models []*model          // Nested models in declaration order.
+}
+
+func newModelBuilder(pkg *sdk.Package, taken map[string]bool) *modelBuilder {
+	if taken == nil {
+		taken = make(map[string]bool)
+	}
+
+	return &modelBuilder{
+		pkg:    pkg,
+		taken:  taken,
+		nested: make(map[string]*model),
+	}
+}
+
+// newAttribute returns the attribute for an SDK member, or nil if the member's type is not supported.
+// ARN-valued strings use the fwtypes.ARN custom type unless they are Computed only.
+func (b *modelBuilder) newAttribute(member string, t *sdk.Type, computedOnly bool, depth int) *attribute {
+	attr := &attribute{
+		Member:    member,
+		Name:      convert.ToSnakeCase(member, ""),
+		FieldName: fieldName(member),
+	}
+	attr.NameExpr = names.ConstOrQuote(attr.Name)
+
+	switch t.Kind {
+	case sdk.KindString:
+		attr.Schema, attr.PlanModifier = "schema.StringAttribute", "String"
+		attr.FieldType = "types.String"
+
+		if strings.HasSuffix(member, "Arn") && !computedOnly {
+			attr.FieldType, attr.CustomType = "fwtypes.ARN", "fwtypes.ARNType"
+		}
+	case sdk.KindBool:
+		attr.Schema, attr.PlanModifier = "schema.BoolAttribute", "Bool"
+		attr.FieldType = "types.Bool"
+	case sdk.KindInt32, sdk.KindInt64:
+		attr.Schema, attr.PlanModifier = "schema.Int64Attribute", "Int64"
+		attr.FieldType = "types.Int64"
+	case sdk.KindFloat32, sdk.KindFloat64:
+		attr.Schema, attr.PlanModifier = "schema.Float64Attribute", "Float64"
+		attr.FieldType = "types.Float64"
+	case sdk.KindTime:
+		attr.Schema, attr.PlanModifier = "schema.StringAttribute", "String"
+		attr.FieldType, attr.CustomType = "timetypes.RFC3339", "timetypes.RFC3339Type{}"
+	case sdk.KindEnum:
+		attr.Schema, attr.PlanModifier = "schema.StringAttribute", "String"
+		attr.FieldType = fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", t.Name)
+		attr.CustomType = fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", t.Name)
+	case sdk.KindList:
+		switch t.Elem.Kind {
+		case sdk.KindString, sdk.KindEnum:
+			attr.Schema, attr.PlanModifier = "schema.ListAttribute", "List"
+			attr.FieldType, attr.CustomType, attr.ElementType = "fwtypes.ListValueOf[types.String]", "fwtypes.ListOfStringType", "types.StringType"
+
+			if strings.HasSuffix(member, "Arns") && t.Elem.Kind == sdk.KindString {
+				attr.FieldType, attr.CustomType, attr.ElementType = "fwtypes.ListValueOf[fwtypes.ARN]", "fwtypes.ListOfARNType", "fwtypes.ARNType"
+			}
+		case sdk.KindStruct:
+			if !b.setNested(attr, t.Elem.Name, computedOnly, depth) {
+				return nil
+			}
+		default:
+			return nil
+		}
+	case sdk.KindMap:
+		if t.Elem.Kind != sdk.KindString {
+			return nil
+		}
+
+		attr.Schema, attr.PlanModifier = "schema.MapAttribute", "Map"
+		attr.FieldType, attr.CustomType, attr.ElementType = "fwtypes.MapValueOf[types.String]", "fwtypes.MapOfStringType", "types.StringType"
+	case sdk.KindStruct:
+		if !b.setNested(attr, t.Name, computedOnly, depth) {
+			return nil
+		}
+
+		if attr.Block {
+			attr.Validators = append(attr.Validators, "listvalidator.SizeAtMost(1)")
+		}
+	default:
+		return nil
+	}
+
+	return attr
+}
+
+// setNested configures attr as a list of nested objects.
+// Computed only members are list attributes, all others are blocks.
+func (b *modelBuilder) setNested(attr *attribute, structName string, computedOnly bool, depth int) bool {
+	nested := b.nestedModel(structName, depth+1)
+	if nested == nil {
+		return false
+	}
+
+	attr.Nested = nested
+	attr.FieldType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", nested.Name)
+	attr.CustomType = fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", nested.Name)
+	attr.PlanModifier, attr.Validator = "List", "List"
+
+	if computedOnly {
+		attr.Schema = "schema.ListAttribute"
+		attr.ElementType = fmt.Sprintf("fwtypes.NewObjectTypeOf[%s](ctx)", nested.Name)
+	} else {
+		attr.Schema = "schema.ListNestedBlock"
+		attr.Block = true
+	}
+
+	return true
+}
+
+// nestedModel returns the model for a structure in the SDK's types package.
+func (b *modelBuilder) nestedModel(structName string, depth int) *model {
+	if m, ok := b.nested[structName]; ok {
+		return m
+	}
+
+	s := b.pkg.Structs[structName]
+	if s == nil || depth > maxNestingDepth {
+		return nil
+	}
+
+	m := &model{Name: b.typeName(convert.ToLowercasePrefix(structName) + "Model")}
+	b.nested[structName] = m
+	b.models = append(b.models, m)
+
+	for _, f := range s.Fields {
+		attr := b.newAttribute(f.Name, f.Type, false, depth)
+		if attr == nil {
+			m.Unsupported = append(m.Unsupported, f.Name)
+			continue
+		}
+
+		if !attr.Block {
+			attr.Required = f.Required
+			attr.Optional = !f.Required
+		} else if f.Required {
+			attr.Validators = append([]string{"listvalidator.IsRequired()", "listvalidator.SizeAtLeast(1)"}, attr.Validators...)
+		}
+
+		m.add(attr)
+	}
+
+	if len(m.Fields) == 0 {
+		delete(b.nested, structName)
+		b.models = slices.DeleteFunc(b.models, func(v *model) bool { return v == m })
+		delete(b.taken, m.Name)
+
+		return nil
+	}
+
+	m.sort()
+
+	return m
+}
+
+// typeName reserves a unique type name in the package.
+func (b *modelBuilder) typeName(name string) string {
+	for i := 2; b.taken[name]; i++ {
+		name = fmt.Sprintf("%s%d", strings.TrimRight(name, "0123456789"), i)
+	}
+
+	b.taken[name] = true
+
+	return name
+}
+
+// fieldNameCaps are words capitalized in model field names.
+var fieldNameCaps = map[string]string{
+	"Acl":  "ACL",
+	"Arn":  "ARN",
+	"Arns": "ARNs",
+	"Dns":  "DNS",
+	"Iam":  "IAM",
+	"Id":   "ID",
+	"Ids":  "IDs",
+	"Ip":   "IP",
+	"Json": "JSON",
+	"Kms":  "KMS",
+	"Sns":  "SNS",
+	"Sqs":  "SQS",
+	"Url":  "URL",
+	"Vpc":  "VPC",
+}
+
+// fieldName returns the model field name for an SDK member, e.g. ModelArn -> ModelARN.
+// AutoFlEx matches the two case-insensitively.
+func fieldName(member string) string {
+	var sb strings.Builder
+
+	for _, word := range strings.Split(convert.ToSnakeCase(member, ""), "_") {
+		if word == "" {
+			continue
+		}
+
+		// Preserve the original casing of the word.
+		i := strings.Index(strings.ToLower(member), word)
+		original := member[i : i+len(word)]
+		member = member[:i] + strings.Repeat("_", len(word)) + member[i+len(word):]
+
+		if v, ok := fieldNameCaps[original]; ok {
+			original = v
+		}
+
+		sb.WriteString(strings.ToUpper(original[:1]) + original[1:])
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,935 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	pluralize "github.com/gertd/go-pluralize"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdk"
+)
+
+//go:embed resourcesdk.tmpl
+var resourceSDKTmpl string
+
+//go:embed datasourcesdk.tmpl
+var dataSourceSDKTmpl string
+
+//go:embed sweepsdk.tmpl
+var sweepSDKTmpl string
+
+//go:embed schemasdk.tmpl
+var schemaSDKTmpl string
+
+// Operations names the AWS SDK for Go v2 operations that a resource is generated from.
+type Operations struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+	List   string
+}
+
+// SDKTemplateData is the data used to generate a resource, a plural data source
+// and a sweeper from AWS SDK for Go v2 operations.
+type SDKTemplateData struct {
+	TemplateData
+
+	GoV2Package   string // AWS SDK for Go v2 service package, e.g. bedrock.
+	ResourceCamel string // Resource name with a lowercase prefix, e.g. provisionedModelThroughput.
+	ResourceVar   string // Variable holding the resource's description.
+	ModelName     string
+	Model         *model
+	Models        []*model
+
+	CreateOperation string
+	ReadOperation   string
+	UpdateOperation string
+	DeleteOperation string
+
+	IDMember            string   // Read operation input member identifying the resource.
+	OtherReadMembers    []string // Other required Read operation input members.
+	IDExpr              string   // Expression for the resource ID after Create.
+	IDExprIsGuess       bool
+	ClientTokenMember   string
+	UpdateIDMember      string
+	UpdateFields        []string // Model fields that can be updated in-place.
+	DeleteIDMember      string
+	FindOutputType      string // e.g. *bedrock.GetThingOutput or *awstypes.Thing.
+	FindOutputMember    string // Set when the description is wrapped in the Read operation's output.
+	NotFoundError       string
+	TagsIdentifierAttr  string
+	StatusMember        string
+	StatusMessageMember string
+	DeletedStatus       string
+	CreatePending       []string
+	CreateTarget        []string
+	UpdatePending       []string
+	DeletePending       []string
+
+	// Plural data source.
+	DataSource          string
+	DataSourceSnake     string
+	HumanDataSourceName string
+	DataSourceModelName string
+	DataSourceModel     *model
+	DataSourceModels    []*model
+	ListOperation       string
+	ListPaginated       bool
+	ListMember          string
+	ListOfIDs           bool // Whether the List operation returns identifiers rather than summaries.
+
+	// Sweeper.
+	SweepFunc     string
+	SweepIDMember string
+}
+
+// CreateFromSDK generates a framework resource, its sweeper and, if a List operation
+// is given, a plural data source from the named AWS SDK for Go v2 operations.
+func CreateFromSDK(resName, snakeName string, ops Operations, comments, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	if ops.Create == "" || ops.Read == "" || ops.Delete == "" {
+		return fmt.Errorf("error checking: Create, Read and Delete operations are required")
+	}
+
+	td, err := newTemplateData(resName, snakeName, filepath.Base(wd), comments, true)
+	if err != nil {
+		return err
+	}
+
+	goV2Package, err := names.AWSGoV2Package(td.ServicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS SDK for Go v2 package name: %w", err)
+	}
+
+	dir, err := sdk.ModuleDir(wd, goV2Package)
+	if err != nil {
+		return err
+	}
+
+	pkg, err := sdk.Load(dir)
+	if err != nil {
+		return err
+	}
+
+	taken, err := declaredTypeNames(wd)
+	if err != nil {
+		return err
+	}
+
+	sdkData, err := newSDKTemplateData(td, pkg, ops, taken)
+	if err != nil {
+		return err
+	}
+	td, snakeName = sdkData.TemplateData, td.ResourceSnake
+
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err = writeSourceTemplate("newres", f, resourceSDKTmpl, force, sdkData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	if sdkData.ListOperation != "" {
+		f := fmt.Sprintf("%s_data_source.go", sdkData.DataSourceSnake)
+		if err = writeSourceTemplate("newds", f, dataSourceSDKTmpl, force, sdkData); err != nil {
+			return fmt.Errorf("writing data source template: %w", err)
+		}
+
+		if err = addSweeper("sweep.go", sdkData); err != nil {
+			return fmt.Errorf("adding sweeper: %w", err)
+		}
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err = writeTemplate("restest", tf, resourceTestTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", td.ServicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	if td.IncludeTags {
+		if _, err := os.Stat("tags_gen.go"); errors.Is(err, fs.ErrNotExist) {
+			fmt.Fprintf(os.Stderr, "%s has tags but the service package has no generated tagging code, add a tags generate directive to generate.go\n", resName)
+		}
+	}
+
+	return nil
+}
+
+func newSDKTemplateData(td TemplateData, pkg *sdk.Package, ops Operations, taken map[string]bool) (SDKTemplateData, error) {
+	data := SDKTemplateData{
+		TemplateData:    td,
+		GoV2Package:     pkg.Name,
+		ResourceCamel:   convert.ToLowercasePrefix(td.Resource),
+		CreateOperation: ops.Create,
+		ReadOperation:   ops.Read,
+		UpdateOperation: ops.Update,
+		DeleteOperation: ops.Delete,
+		NotFoundError:   pkg.NotFoundError(),
+	}
+
+	create, err := pkg.Operation(ops.Create)
+	if err != nil {
+		return data, err
+	}
+
+	read, err := pkg.Operation(ops.Read)
+	if err != nil {
+		return data, err
+	}
+
+	del, err := pkg.Operation(ops.Delete)
+	if err != nil {
+		return data, err
+	}
+
+	var update *sdk.Operation
+	if ops.Update != "" {
+		if update, err = pkg.Operation(ops.Update); err != nil {
+			return data, err
+		}
+	}
+
+	// The resource is identified by the Read operation's first required string member.
+	for _, f := range read.Input.RequiredFields() {
+		switch {
+		case data.IDMember == "" && f.Type.Kind == sdk.KindString:
+			data.IDMember = f.Name
+		default:
+			data.OtherReadMembers = append(data.OtherReadMembers, f.Name)
+		}
+	}
+
+	if data.IDMember == "" {
+		return data, fmt.Errorf("operation %s has no required string input member identifying the resource", ops.Read)
+	}
+
+	// The resource's description is either the Read operation's output or a structure wrapped in it.
+	description := read.Output
+	data.FindOutputType = fmt.Sprintf("*%s.%s", pkg.Name, read.Output.Name)
+
+	if len(read.Output.Fields) == 1 && read.Output.Fields[0].Type.Kind == sdk.KindStruct {
+		f := read.Output.Fields[0]
+		description = pkg.Structs[f.Type.Name]
+		data.FindOutputType = fmt.Sprintf("*awstypes.%s", f.Type.Name)
+		data.FindOutputMember = f.Name
+	}
+
+	data.ResourceVar = data.ResourceCamel
+	if reservedVars[data.ResourceVar] || data.ResourceVar == pkg.Name {
+		data.ResourceVar = "out"
+	}
+
+	for _, v := range []string{"ClientToken", "ClientRequestToken", "IdempotencyToken"} {
+		if f := create.Input.Field(v); f != nil && f.Type.Kind == sdk.KindString {
+			data.ClientTokenMember = v
+			break
+		}
+	}
+
+	data.IncludeTags = create.Input.Field("Tags") != nil || pkg.Operations["TagResource"] != nil
+
+	b := newModelBuilder(pkg, taken)
+	data.ModelName = b.typeName(data.ResourceCamel + "ResourceModel")
+	data.Model = &model{Name: data.ModelName}
+	data.Model.add(&attribute{
+		Name:       names.AttrID,
+		NameExpr:   "names.AttrID",
+		FieldName:  "ID",
+		FieldType:  "types.String",
+		Definition: "framework.IDAttribute()",
+	})
+
+	skip := func(member string) bool {
+		return member == data.ClientTokenMember || member == "Tags" || member == "DryRun" || fieldName(member) == "ID"
+	}
+	// The resource's identifier is an Update operation input member but cannot itself be updated.
+	updatable := func(member string) bool {
+		return update != nil && update.Input.Field(member) != nil && member != data.IDMember
+	}
+
+	for _, f := range create.Input.Fields {
+		if skip(f.Name) {
+			continue
+		}
+
+		attr := b.newAttribute(f.Name, f.Type, false, 0)
+		if attr == nil {
+			data.Model.Unsupported = append(data.Model.Unsupported, f.Name)
+			continue
+		}
+
+		if attr.Block {
+			if f.Required {
+				attr.Validators = append([]string{"listvalidator.IsRequired()", "listvalidator.SizeAtLeast(1)"}, attr.Validators...)
+			}
+		} else {
+			attr.Required = f.Required
+			attr.Optional = !f.Required
+			attr.Computed = !f.Required && description.Field(f.Name) != nil
+		}
+
+		if updatable(f.Name) {
+			data.UpdateFields = append(data.UpdateFields, attr.FieldName)
+		} else {
+			attr.PlanModifiers = append(attr.PlanModifiers, planModifier(attr, "RequiresReplace"))
+		}
+
+		if attr.Computed {
+			attr.PlanModifiers = append(attr.PlanModifiers, planModifier(attr, "UseStateForUnknown"))
+		}
+
+		data.Model.add(attr)
+	}
+
+	for _, f := range description.Fields {
+		if create.Input.Field(f.Name) != nil || skip(f.Name) {
+			continue
+		}
+
+		attr := b.newAttribute(f.Name, f.Type, true, 0)
+		if attr == nil {
+			data.Model.Unsupported = append(data.Model.Unsupported, f.Name)
+			continue
+		}
+
+		attr.Computed = true
+
+		switch {
+		case attr.FieldType == "types.String" && strings.HasSuffix(f.Name, "Arn"):
+			attr.Definition = "framework.ARNAttributeComputedOnly()"
+		case attr.Nested == nil && !isStatusMember(f.Name):
+			attr.PlanModifiers = append(attr.PlanModifiers, planModifier(attr, "UseStateForUnknown"))
+		}
+
+		data.Model.add(attr)
+	}
+
+	if update != nil && len(data.UpdateFields) == 0 {
+		fmt.Fprintf(os.Stderr, "no %s input members match %s arguments, update %s manually\n", ops.Update, ops.Create, td.ProviderResourceName)
+		update, data.UpdateOperation = nil, ""
+	}
+
+	if update != nil && update.Input.Field(data.IDMember) != nil {
+		data.UpdateIDMember = data.IDMember
+	}
+
+	if f := del.Input.Field(data.IDMember); f != nil && f.Type.Kind == sdk.KindString {
+		data.DeleteIDMember = data.IDMember
+	}
+
+	data.IDExpr, data.IDExprIsGuess = idExpr(pkg, create, data.IDMember)
+	data.TagsIdentifierAttr = tagsIdentifierAttribute(data.Model, td.Resource, data.IDExpr)
+
+	data.setStatus(pkg, description, update != nil)
+
+	if data.IncludeTags {
+		data.Model.add(&attribute{Name: names.AttrTags, NameExpr: "names.AttrTags", FieldName: "Tags", FieldType: "types.Map", Definition: "tftags.TagsAttribute()"})
+		data.Model.add(&attribute{Name: names.AttrTagsAll, NameExpr: "names.AttrTagsAll", FieldName: "TagsAll", FieldType: "types.Map", Definition: "tftags.TagsAttributeComputedOnly()"})
+	}
+
+	if data.HasTimeouts() {
+		// Timeouts are a block in the schema but are declared separately.
+		data.Model.Fields = append(data.Model.Fields, &attribute{Name: names.AttrTimeouts, FieldName: "Timeouts", FieldType: "timeouts.Value"})
+	}
+
+	data.Model.sort()
+	data.Models = b.models
+
+	if ops.List != "" {
+		if err := data.setList(pkg, b, ops.List); err != nil {
+			return data, err
+		}
+
+		data.DataSourceModels = b.models[len(data.Models):]
+	}
+
+	return data, nil
+}
+
+// HasTimeouts returns whether the resource has any waiters.
+func (data SDKTemplateData) HasTimeouts() bool {
+	return len(data.CreatePending) > 0 || len(data.UpdatePending) > 0 || len(data.DeletePending) > 0
+}
+
+// IDFromOutput returns whether the resource ID is set from the Create operation's output.
+func (data SDKTemplateData) IDFromOutput() bool {
+	return strings.HasPrefix(data.IDExpr, "output.")
+}
+
+// reservedVars are identifiers that the generated code already uses.
+var reservedVars = map[string]bool{
+	"aws": true, "awstypes": true, "conn": true, "data": true, "diag": true, "enum": true, "err": true, "errs": true,
+	"framework": true, "fwflex": true, "fwtypes": true, "id": true, "input": true, "names": true, "new": true,
+	"old": true, "output": true, "resource": true, "retry": true, "schema": true, "tfresource": true, "time": true,
+	"timeouts": true, "types": true,
+}
+
+func planModifier(attr *attribute, name string) string {
+	return fmt.Sprintf("%splanmodifier.%s()", strings.ToLower(attr.PlanModifier), name)
+}
+
+func isStatusMember(member string) bool {
+	return strings.HasSuffix(member, "Status") || strings.HasSuffix(member, "State")
+}
+
+// idExpr returns the expression for the new resource's ID.
+// If the ID can only be guessed at, the second return value is true.
+func idExpr(pkg *sdk.Package, create *sdk.Operation, idMember string) (string, bool) {
+	if f := create.Output.Field(idMember); f != nil && f.Type.Kind == sdk.KindString {
+		return "output." + idMember, false
+	}
+
+	if f := create.Input.Field(idMember); f != nil && f.Type.Kind == sdk.KindString {
+		return "input." + idMember, false
+	}
+
+	for _, f := range create.Output.Fields {
+		if f.Type.Kind == sdk.KindStruct {
+			if v := pkg.Structs[f.Type.Name].Field(idMember); v != nil && v.Type.Kind == sdk.KindString {
+				return fmt.Sprintf("output.%s.%s", f.Name, idMember), false
+			}
+		}
+	}
+
+	var candidates []string
+	for _, f := range create.Output.Fields {
+		if f.Type.Kind == sdk.KindString {
+			candidates = append(candidates, f.Name)
+		}
+	}
+
+	if len(candidates) == 1 {
+		return "output." + candidates[0], false
+	}
+
+	for _, suffix := range []string{"Arn", "Id", "Name"} {
+		for _, v := range candidates {
+			if strings.HasSuffix(v, suffix) {
+				return "output." + v, true
+			}
+		}
+	}
+
+	return "", true
+}
+
+// tagsIdentifierAttribute returns the attribute holding the resource's ARN.
+func tagsIdentifierAttribute(m *model, resource, idExpr string) string {
+	var members []string
+	attrs := make(map[string]*attribute)
+
+	for _, attr := range m.Fields {
+		if !strings.HasSuffix(attr.Member, "Arn") || attr.Nested != nil {
+			continue
+		}
+
+		if strings.HasSuffix(idExpr, "."+attr.Member) {
+			return attr.Name
+		}
+
+		members = append(members, attr.Member)
+		attrs[attr.Member] = attr
+	}
+
+	if v := closestMember(members, resource); v != "" {
+		return attrs[v].Name
+	}
+
+	return names.AttrID
+}
+
+// closestMember returns the member whose name shares the longest prefix with the resource name.
+func closestMember(members []string, resource string) string {
+	var closest string
+	longest := -1
+
+	for _, member := range members {
+		n := 0
+		for n < len(member) && n < len(resource) && member[n] == resource[n] {
+			n++
+		}
+
+		if member == "Arn" || member == "Id" || strings.TrimSuffix(strings.TrimSuffix(member, "Arn"), "Id") == resource {
+			n = len(resource) + 1
+		}
+
+		if n > longest {
+			closest, longest = member, n
+		}
+	}
+
+	return closest
+}
+
+// setStatus determines the resource's status member and the statuses its waiters wait on.
+func (data *SDKTemplateData) setStatus(pkg *sdk.Package, description *sdk.Struct, hasUpdate bool) {
+	var status *sdk.Field
+
+	for _, v := range []string{"Status", "State"} {
+		if f := description.Field(v); f != nil && f.Type.Kind == sdk.KindEnum {
+			status = f
+			break
+		}
+	}
+
+	if status == nil {
+		for _, f := range description.Fields {
+			if isStatusMember(f.Name) && f.Type.Kind == sdk.KindEnum {
+				status = f
+				break
+			}
+		}
+	}
+
+	if status == nil {
+		return
+	}
+
+	data.StatusMember = status.Name
+
+	for _, v := range []string{"FailureMessage", "FailureReason", "StatusReason", "StatusMessage", "StateReason", "StateMessage"} {
+		if f := description.Field(v); f != nil && f.Type.Kind == sdk.KindString {
+			data.StatusMessageMember = v
+			break
+		}
+	}
+
+	var creating, created, updating, deleting []string
+
+	for _, v := range pkg.Enums[status.Type.Name] {
+		value := strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(v.Value))
+		constant := "awstypes." + v.Name
+
+		switch {
+		case value == "deleted":
+			data.DeletedStatus = constant
+		case strings.Contains(value, "delet"):
+			deleting = append(deleting, constant)
+		case strings.Contains(value, "updat") || strings.Contains(value, "modifying"):
+			updating = append(updating, constant)
+		case slices.Contains(targetStatuses, value):
+			created = append(created, constant)
+		case strings.Contains(value, "creat") || strings.Contains(value, "pending") || strings.Contains(value, "provision") ||
+			strings.Contains(value, "inprogress") || strings.Contains(value, "starting") || strings.Contains(value, "initializ"):
+			creating = append(creating, constant)
+		}
+	}
+
+	if len(creating) > 0 && len(created) > 0 {
+		data.CreatePending, data.CreateTarget = creating, created
+	}
+
+	if hasUpdate && len(updating) > 0 && len(created) > 0 {
+		data.UpdatePending = updating
+	}
+
+	data.DeletePending = deleting
+}
+
+// targetStatuses are normalized statuses of a resource that is ready for use.
+var targetStatuses = []string{
+	"active",
+	"available",
+	"complete",
+	"completed",
+	"created",
+	"deployed",
+	"enabled",
+	"inservice",
+	"ready",
+	"running",
+	"succeeded",
+	"success",
+}
+
+// setList configures the plural data source and sweeper from the List operation.
+func (data *SDKTemplateData) setList(pkg *sdk.Package, b *modelBuilder, name string) error {
+	list, err := pkg.Operation(name)
+	if err != nil {
+		return err
+	}
+
+	// Prefer a list of summaries to a list of identifiers.
+	var member *sdk.Field
+	for _, kind := range []sdk.Kind{sdk.KindStruct, sdk.KindString} {
+		for _, f := range list.Output.Fields {
+			if member == nil && f.Type.Kind == sdk.KindList && f.Type.Elem.Kind == kind {
+				member = f
+			}
+		}
+	}
+
+	if member == nil {
+		return fmt.Errorf("operation %s has no output list of structures or strings", name)
+	}
+
+	plural := pluralize.NewClient()
+
+	data.ListOperation = list.Name
+	data.ListPaginated = list.Paginated
+	data.ListMember = member.Name
+	data.DataSource = plural.Plural(data.Resource)
+	data.DataSourceSnake = plural.Plural(data.ResourceSnake)
+	data.HumanDataSourceName = plural.Plural(data.HumanResourceName)
+	data.DataSourceModelName = b.typeName(convert.ToLowercasePrefix(data.DataSource) + "DataSourceModel")
+	data.SweepFunc = "sweep" + data.DataSource
+
+	data.DataSourceModel = &model{Name: data.DataSourceModelName}
+	data.DataSourceModel.add(&attribute{
+		Name:       names.AttrID,
+		NameExpr:   "names.AttrID",
+		FieldName:  "ID",
+		FieldType:  "types.String",
+		Definition: "framework.IDAttribute()",
+	})
+
+	for _, f := range list.Input.Fields {
+		if f.Name == "NextToken" || f.Name == "MaxResults" {
+			continue
+		}
+
+		attr := b.newAttribute(f.Name, f.Type, false, 0)
+		if attr == nil || attr.Block {
+			data.DataSourceModel.Unsupported = append(data.DataSourceModel.Unsupported, f.Name)
+			continue
+		}
+
+		attr.Required = f.Required
+		attr.Optional = !f.Required
+		data.DataSourceModel.add(attr)
+	}
+
+	attr := b.newAttribute(member.Name, member.Type, true, 0)
+	if attr == nil {
+		return fmt.Errorf("operation %s output member %s is not supported", name, member.Name)
+	}
+
+	attr.Computed = true
+	data.DataSourceModel.add(attr)
+	data.DataSourceModel.sort()
+
+	// Sweepers delete listed resources by ID.
+	if member.Type.Elem.Kind == sdk.KindString {
+		data.ListOfIDs = true
+
+		return nil
+	}
+
+	summary := pkg.Structs[member.Type.Elem.Name]
+	candidates := []string{data.IDMember, data.IDExpr[strings.LastIndex(data.IDExpr, ".")+1:], "Arn", "Id", "Name"}
+
+	for _, v := range candidates {
+		if f := summary.Field(v); f != nil && f.Type.Kind == sdk.KindString {
+			data.SweepIDMember = v
+			break
+		}
+	}
+
+	if data.SweepIDMember == "" {
+		var members []string
+		for _, f := range summary.Fields {
+			if f.Type.Kind == sdk.KindString && (strings.HasSuffix(f.Name, "Arn") || strings.HasSuffix(f.Name, "Id")) {
+				members = append(members, f.Name)
+			}
+		}
+
+		data.SweepIDMember = closestMember(members, data.Resource)
+	}
+
+	return nil
+}
+
+// declaredTypeNames returns the names of the types declared in the Go package in dir.
+func declaredTypeNames(dir string) (map[string]bool, error) {
+	typeNames := make(map[string]bool)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+
+	for _, filename := range files {
+		f, err := parser.ParseFile(fset, filename, nil, parser.SkipObjectResolution)
+		if err != nil {
+			continue // Ignore files that don't parse, e.g. those being regenerated.
+		}
+
+		for _, decl := range f.Decls {
+			if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.TYPE {
+				for _, spec := range decl.Specs {
+					typeNames[spec.(*ast.TypeSpec).Name.Name] = true
+				}
+			}
+		}
+	}
+
+	return typeNames, nil
+}
+
+func newTemplate(name, tmpl string) (*template.Template, error) {
+	return template.New(name).Funcs(template.FuncMap{
+		"join": strings.Join,
+	}).Parse(schemaSDKTmpl + tmpl)
+}
+
+// executeSourceTemplate executes tmpl and returns formatted Go source with unused imports removed.
+func executeSourceTemplate(name, tmpl string, data any) ([]byte, error) {
+	t, err := newTemplate(name, tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := t.Execute(&buffer, data); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	return formatSource(buffer.Bytes())
+}
+
+func writeSourceTemplate(templateName, filename, tmpl string, force bool, data any) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	contents, err := executeSourceTemplate(templateName, tmpl, data)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+// formatSource removes unused imports from Go source and formats it.
+func formatSource(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing generated file: %s", err)
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok {
+				used[x.Name] = true
+			}
+		}
+
+		return true
+	})
+
+	unused := make(map[int]bool)
+	for _, spec := range f.Imports {
+		if spec.Name != nil && (spec.Name.Name == "_" || spec.Name.Name == ".") {
+			continue
+		}
+
+		p, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Base(p)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		} else if strings.HasPrefix(name, "v") && strings.Trim(name[1:], "0123456789") == "" {
+			name = path.Base(path.Dir(p))
+		}
+
+		if !used[name] {
+			unused[fset.Position(spec.Pos()).Line] = true
+		}
+	}
+
+	var lines [][]byte
+	for i, line := range bytes.Split(src, []byte("\n")) {
+		if !unused[i+1] {
+			lines = append(lines, line)
+		}
+	}
+
+	contents, err := format.Source(bytes.Join(lines, []byte("\n")))
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated file: %s", err)
+	}
+
+	return contents, nil
+}
+
+// addSweeper adds the resource's sweeper to the service package's sweepers.
+func addSweeper(filename string, data SDKTemplateData) error {
+	if data.SweepIDMember == "" && !data.ListOfIDs {
+		fmt.Fprintf(os.Stderr, "no identifier found in %s output, add a sweeper for %s manually\n", data.ListOperation, data.ProviderResourceName)
+
+		return nil
+	}
+
+	src, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		src = []byte(fmt.Sprintf("// Copyright (c) HashiCorp, Inc.\n// SPDX-License-Identifier: MPL-2.0\n\npackage %s\n\nfunc RegisterSweepers() {\n}\n", data.ServicePackage))
+	} else if err != nil {
+		return err
+	}
+
+	contents, err := appendSweeper(src, data)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, contents, 0644)
+}
+
+// appendSweeper returns the sweepers source src with the resource's sweeper added.
+func appendSweeper(src []byte, data SDKTemplateData) ([]byte, error) {
+	t, err := newTemplate("sweep", sweepSDKTmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var registration, function bytes.Buffer
+	if err := t.ExecuteTemplate(&registration, "registration", data); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+	if err := t.ExecuteTemplate(&function, "function", data); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	imports := append(slices.Clone(sweeperImports), fmt.Sprintf("%q", "github.com/aws/aws-sdk-go-v2/service/"+data.GoV2Package))
+
+	src, err = insertSweeper(src, registration.Bytes(), function.Bytes(), imports)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatSource(src)
+}
+
+// sweeperImports are the imports required by a generated sweeper.
+var sweeperImports = []string{
+	`"fmt"`,
+	`"log"`,
+	`"github.com/aws/aws-sdk-go-v2/aws"`,
+	`"github.com/hashicorp/terraform-plugin-testing/helper/resource"`,
+	`"github.com/hashicorp/terraform-provider-aws/internal/sweep"`,
+	`"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"`,
+	`"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"`,
+	`"github.com/hashicorp/terraform-provider-aws/names"`,
+}
+
+// insertSweeper inserts a sweeper's registration at the end of RegisterSweepers,
+// appends the sweeper function and adds any missing imports.
+func insertSweeper(src, registration, function []byte, imports []string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing sweepers: %s", err)
+	}
+
+	var register *ast.FuncDecl
+	for _, decl := range f.Decls {
+		if decl, ok := decl.(*ast.FuncDecl); ok && decl.Recv == nil && decl.Name.Name == "RegisterSweepers" {
+			register = decl
+		}
+	}
+
+	if register == nil {
+		return nil, errors.New("no RegisterSweepers function found")
+	}
+
+	existing := make(map[string]bool)
+	for _, spec := range f.Imports {
+		existing[spec.Path.Value] = true
+	}
+
+	rbrace := fset.Position(register.Body.Rbrace).Offset
+
+	var out bytes.Buffer
+	out.Write(src[:rbrace])
+	if len(register.Body.List) > 0 {
+		out.WriteString("\n")
+	}
+	out.Write(bytes.TrimLeft(registration, "\n"))
+	out.WriteString("\n")
+	out.Write(src[rbrace:])
+	out.WriteString("\n")
+	out.Write(function)
+
+	src = out.Bytes()
+
+	var std, other []string
+	for _, v := range imports {
+		if existing[v] {
+			continue
+		}
+
+		if strings.Contains(v, ".") {
+			other = append(other, "\t"+v+"\n")
+		} else {
+			std = append(std, "\t"+v+"\n")
+		}
+	}
+
+	if len(std) == 0 && len(other) == 0 {
+		return src, nil
+	}
+
+	if len(f.Imports) == 0 {
+		end := fset.Position(f.Name.End()).Offset
+
+		return slices.Concat(src[:end], []byte("\n\nimport (\n"+strings.Join(std, "")+"\n"+strings.Join(other, "")+")\n"), src[end:]), nil
+	}
+
+	var decl *ast.GenDecl
+	for _, d := range f.Decls {
+		if d, ok := d.(*ast.GenDecl); ok && d.Tok == token.IMPORT {
+			decl = d
+			break
+		}
+	}
+
+	if decl == nil || !decl.Lparen.IsValid() {
+		return nil, errors.New("sweepers must use a parenthesized import declaration")
+	}
+
+	// Standard library imports are added to the first import group and all others to the last.
+	// Formatting sorts each group.
+	lparen, rparen := fset.Position(decl.Lparen).Offset+1, fset.Position(decl.Rparen).Offset
+	if src[lparen] == '\n' {
+		lparen++
+	}
+
+	return slices.Concat(src[:lparen], []byte(strings.Join(std, "")), src[lparen:rparen], []byte(strings.Join(other, "")), src[rparen:]), nil
+}
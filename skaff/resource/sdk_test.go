@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdk"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+const testSweepers = `// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func RegisterSweepers() {
+	resource.AddTestSweepers("aws_bedrock_example", &resource.Sweeper{
+		Name: "aws_bedrock_example",
+		F:    sweepExamples,
+	})
+}
+
+func sweepExamples(region string) error {
+	return fmt.Errorf("not implemented")
+}
+`
+
+func testSDKTemplateData(t *testing.T) SDKTemplateData {
+	t.Helper()
+
+	pkg, err := sdk.Load(filepath.Join("..", "sdk", "testdata", "thing"))
+	if err != nil {
+		t.Fatalf("loading SDK package: %s", err)
+	}
+
+	td, err := newTemplateData("Thing", "", "bedrock", false, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ops := Operations{
+		Create: "CreateThing",
+		Read:   "GetThing",
+		Update: "UpdateThing",
+		Delete: "DeleteThing",
+		List:   "ListThings",
+	}
+
+	data, err := newSDKTemplateData(td, pkg, ops, map[string]bool{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func TestCreateFromSDK(t *testing.T) {
+	t.Parallel()
+
+	data := testSDKTemplateData(t)
+
+	testCases := map[string]struct {
+		generate func() ([]byte, error)
+	}{
+		"resource": {
+			generate: func() ([]byte, error) { return executeSourceTemplate("newres", resourceSDKTmpl, data) },
+		},
+		"data_source": {
+			generate: func() ([]byte, error) { return executeSourceTemplate("newds", dataSourceSDKTmpl, data) },
+		},
+		"sweep": {
+			generate: func() ([]byte, error) { return appendSweeper([]byte(testSweepers), data) },
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.generate()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			golden := filepath.Join("testdata", name+".golden")
+
+			if *update {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("reading golden file (run with -update to create it): %s", err)
+			}
+
+			if string(got) != string(want) {
+				t.Errorf("generated source does not match %s (run with -update to see the difference):\n%s", golden, got)
+			}
+		})
+	}
+}
+
+func TestSDKTemplateData(t *testing.T) {
+	t.Parallel()
+
+	data := testSDKTemplateData(t)
+
+	testCases := map[string]struct {
+		got, want any
+	}{
+		"ID member":          {data.IDMember, "ThingId"},
+		"ID expression":      {data.IDExpr, "output.ThingId"},
+		"client token":       {data.ClientTokenMember, "ClientToken"},
+		"find output member": {data.FindOutputMember, "Thing"},
+		"tags":               {data.IncludeTags, true},
+		"not found error":    {data.NotFoundError, "ResourceNotFoundException"},
+		"status member":      {data.StatusMember, "Status"},
+		"status message":     {data.StatusMessageMember, "StatusReason"},
+		"data source":        {data.DataSource, "Things"},
+		"list member":        {data.ListMember, "ThingSummaries"},
+		"sweep ID member":    {data.SweepIDMember, "ThingId"},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if testCase.got != testCase.want {
+				t.Errorf("got %v, want %v", testCase.got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestFieldName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Name":                 "Name",
+		"RoleArn":              "RoleARN",
+		"ThingId":              "ThingID",
+		"SubnetIds":            "SubnetIDs",
+		"VpcConfig":            "VPCConfig",
+		"ProvisionedModelArns": "ProvisionedModelARNs",
+	}
+
+	for member, want := range testCases {
+		member, want := member, want
+
+		t.Run(member, func(t *testing.T) {
+			t.Parallel()
+
+			if got := fieldName(member); got != want {
+				t.Errorf("fieldName(%q) = %q, want %q", member, got, want)
+			}
+		})
+	}
+}
+
+func TestClosestMember(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		members  []string
+		resource string
+		want     string
+	}{
+		"own ARN": {
+			members:  []string{"ModelArn", "Arn", "ProvisionedModelArn"},
+			resource: "ProvisionedModelThroughput",
+			want:     "Arn",
+		},
+		"resource name": {
+			members:  []string{"RoleArn", "ClusterArn"},
+			resource: "Cluster",
+			want:     "ClusterArn",
+		},
+		"longest prefix": {
+			members:  []string{"DesiredModelArn", "ProvisionedModelArn"},
+			resource: "ProvisionedModelThroughput",
+			want:     "ProvisionedModelArn",
+		},
+		"no members": {
+			resource: "Cluster",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := closestMember(testCase.members, testCase.resource); got != testCase.want {
+				t.Errorf("closestMember() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestFormatSource(t *testing.T) {
+	t.Parallel()
+
+	src := `package example
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+func f(context.Context) *eks.Client {
+	return nil
+}
+`
+	want := `package example
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+)
+
+func f(context.Context) *eks.Client {
+	return nil
+}
+`
+
+	got, err := formatSource([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != want {
+		t.Errorf("formatSource() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sdk reads the source of an AWS SDK for Go v2 service package so that
+// scaffolding can be derived from its operations and shapes.
+package sdk
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Kind classifies the Go type of an SDK structure member.
+type Kind int
+
+const (
+	KindUnsupported Kind = iota
+	KindString
+	KindBool
+	KindInt32
+	KindInt64
+	KindFloat32
+	KindFloat64
+	KindTime
+	KindEnum
+	KindStruct
+	KindList
+	KindMap
+	KindDocument
+	KindUnion
+
+	kindNamed // Unresolved reference to a shape in the types package.
+)
+
+// Type is the type of an SDK structure member.
+type Type struct {
+	Kind Kind
+	Name string // Shape name for enums, structures and unions.
+	Elem *Type  // Element type for lists and maps.
+}
+
+// Field is a member of an SDK structure.
+type Field struct {
+	Name     string
+	Type     *Type
+	Required bool
+}
+
+// Struct is an SDK structure, either an operation's input or output or a shape
+// in the types package.
+type Struct struct {
+	Name   string
+	Fields []*Field
+}
+
+// Field returns the named member, or nil if there is no such member.
+func (s *Struct) Field(name string) *Field {
+	if s == nil {
+		return nil
+	}
+
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// RequiredFields returns the members marked as required.
+func (s *Struct) RequiredFields() []*Field {
+	var fields []*Field
+
+	if s == nil {
+		return fields
+	}
+
+	for _, f := range s.Fields {
+		if f.Required {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// Operation is an API operation.
+type Operation struct {
+	Name      string
+	Input     *Struct
+	Output    *Struct
+	Paginated bool
+}
+
+// EnumValue is a single value of an SDK enum.
+type EnumValue struct {
+	Name  string // Go constant name, e.g. ProvisionedModelStatusCreating.
+	Value string // Wire value, e.g. Creating.
+}
+
+// Package is the parsed source of an AWS SDK for Go v2 service package.
+type Package struct {
+	Name       string
+	Operations map[string]*Operation
+	Structs    map[string]*Struct
+	Enums      map[string][]EnumValue
+	Unions     map[string]bool
+	Errors     []string
+}
+
+// ModuleDir returns the directory containing the source of the AWS SDK for Go v2
+// service package named goV2Package, as resolved from the Go module in dir.
+func ModuleDir(dir, goV2Package string) (string, error) {
+	module := fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s", goV2Package)
+
+	cmd := exec.Command("go", "list", "-m", "-f", "{{ .Dir }}", module)
+	cmd.Dir = dir
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("locating AWS SDK for Go v2 module (%s): %w", module, err)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Load parses the SDK service package source in dir.
+func Load(dir string) (*Package, error) {
+	pkg := &Package{
+		Name:       filepath.Base(dir),
+		Operations: make(map[string]*Operation),
+		Structs:    make(map[string]*Struct),
+		Enums:      make(map[string][]EnumValue),
+		Unions:     make(map[string]bool),
+	}
+
+	if i := strings.LastIndex(pkg.Name, "@"); i > 0 {
+		pkg.Name = pkg.Name[:i]
+	}
+
+	fset := token.NewFileSet()
+
+	files, err := filepath.Glob(filepath.Join(dir, "api_op_*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no API operations found in %s", dir)
+	}
+
+	for _, filename := range files {
+		f, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(filename), "api_op_"), ".go")
+		op := &Operation{Name: name}
+
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					spec, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+
+					st, ok := spec.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+
+					switch spec.Name.Name {
+					case name + "Input":
+						op.Input = newStruct(spec.Name.Name, st, true)
+					case name + "Output":
+						op.Output = newStruct(spec.Name.Name, st, true)
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv == nil && decl.Name.Name == "New"+name+"Paginator" {
+					op.Paginated = true
+				}
+			}
+		}
+
+		pkg.Operations[name] = op
+	}
+
+	for _, filename := range []string{"types.go", "enums.go", "errors.go"} {
+		filename = filepath.Join(dir, "types", filename)
+
+		if matches, _ := filepath.Glob(filename); len(matches) == 0 {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		pkg.addTypes(f, filepath.Base(filename) == "errors.go")
+	}
+
+	slices.Sort(pkg.Errors)
+
+	for _, op := range pkg.Operations {
+		pkg.resolveStruct(op.Input)
+		pkg.resolveStruct(op.Output)
+	}
+
+	for _, s := range pkg.Structs {
+		pkg.resolveStruct(s)
+	}
+
+	return pkg, nil
+}
+
+// Operation returns the named operation.
+func (p *Package) Operation(name string) (*Operation, error) {
+	op, ok := p.Operations[name]
+	if !ok {
+		return nil, fmt.Errorf("operation %s not found in AWS SDK for Go v2 package %s", name, p.Name)
+	}
+
+	if op.Input == nil || op.Output == nil {
+		return nil, fmt.Errorf("operation %s has no input or output structure", name)
+	}
+
+	return op, nil
+}
+
+// NotFoundError returns the name of the error returned when a resource does not
+// exist, or an empty string if the service does not model one.
+func (p *Package) NotFoundError() string {
+	if slices.Contains(p.Errors, "ResourceNotFoundException") {
+		return "ResourceNotFoundException"
+	}
+
+	for _, v := range p.Errors {
+		if strings.Contains(v, "NotFound") {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func (p *Package) addTypes(f *ast.File, errors bool) {
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+
+		switch decl.Tok {
+		case token.TYPE:
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.TypeSpec)
+
+				if !spec.Name.IsExported() {
+					continue
+				}
+
+				switch t := spec.Type.(type) {
+				case *ast.StructType:
+					if errors {
+						p.Errors = append(p.Errors, spec.Name.Name)
+					} else {
+						p.Structs[spec.Name.Name] = newStruct(spec.Name.Name, t, false)
+					}
+				case *ast.InterfaceType:
+					p.Unions[spec.Name.Name] = true
+				case *ast.Ident:
+					if t.Name == "string" {
+						if _, ok := p.Enums[spec.Name.Name]; !ok {
+							p.Enums[spec.Name.Name] = nil
+						}
+					}
+				}
+			}
+		case token.CONST:
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.ValueSpec)
+
+				typ, ok := spec.Type.(*ast.Ident)
+				if !ok || len(spec.Names) != 1 || len(spec.Values) != 1 {
+					continue
+				}
+
+				lit, ok := spec.Values[0].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+
+				p.Enums[typ.Name] = append(p.Enums[typ.Name], EnumValue{
+					Name:  spec.Names[0].Name,
+					Value: strings.Trim(lit.Value, "\"`"),
+				})
+			}
+		}
+	}
+}
+
+func (p *Package) resolveStruct(s *Struct) {
+	if s == nil {
+		return
+	}
+
+	for _, f := range s.Fields {
+		p.resolveType(f.Type)
+	}
+}
+
+func (p *Package) resolveType(t *Type) {
+	switch t.Kind {
+	case KindList, KindMap:
+		p.resolveType(t.Elem)
+	case kindNamed:
+		switch {
+		case p.Unions[t.Name]:
+			t.Kind = KindUnion
+		case p.Structs[t.Name] != nil:
+			t.Kind = KindStruct
+		default:
+			if _, ok := p.Enums[t.Name]; ok {
+				t.Kind = KindEnum
+			} else {
+				t.Kind = KindUnsupported
+			}
+		}
+	}
+}
+
+func newStruct(name string, st *ast.StructType, qualified bool) *Struct {
+	s := &Struct{Name: name}
+
+	for _, field := range st.Fields.List {
+		for _, ident := range field.Names {
+			if !ident.IsExported() || ident.Name == "ResultMetadata" {
+				continue
+			}
+
+			s.Fields = append(s.Fields, &Field{
+				Name:     ident.Name,
+				Type:     newType(field.Type, qualified),
+				Required: field.Doc != nil && strings.Contains(field.Doc.Text(), "This member is required."),
+			})
+		}
+	}
+
+	return s
+}
+
+// newType classifies a member's type expression.
+// Shapes are qualified with the types package in operation files but not in the types package itself.
+func newType(expr ast.Expr, qualified bool) *Type {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return newType(expr.X, qualified)
+	case *ast.Ident:
+		switch expr.Name {
+		case "string":
+			return &Type{Kind: KindString}
+		case "bool":
+			return &Type{Kind: KindBool}
+		case "int32":
+			return &Type{Kind: KindInt32}
+		case "int64":
+			return &Type{Kind: KindInt64}
+		case "float32":
+			return &Type{Kind: KindFloat32}
+		case "float64":
+			return &Type{Kind: KindFloat64}
+		}
+
+		if !qualified && expr.IsExported() {
+			return &Type{Kind: kindNamed, Name: expr.Name}
+		}
+	case *ast.SelectorExpr:
+		if x, ok := expr.X.(*ast.Ident); ok {
+			switch {
+			case x.Name == "time" && expr.Sel.Name == "Time":
+				return &Type{Kind: KindTime}
+			case x.Name == "document" && expr.Sel.Name == "Interface":
+				return &Type{Kind: KindDocument}
+			case x.Name == "types" && qualified:
+				return &Type{Kind: kindNamed, Name: expr.Sel.Name}
+			}
+		}
+	case *ast.ArrayType:
+		if expr.Len == nil {
+			if elem, ok := expr.Elt.(*ast.Ident); ok && elem.Name == "byte" {
+				break
+			}
+
+			return &Type{Kind: KindList, Elem: newType(expr.Elt, qualified)}
+		}
+	case *ast.MapType:
+		if key, ok := expr.Key.(*ast.Ident); ok && key.Name == "string" {
+			return &Type{Kind: KindMap, Elem: newType(expr.Value, qualified)}
+		}
+	}
+
+	return &Type{Kind: KindUnsupported}
+}
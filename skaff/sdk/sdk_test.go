@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	pkg, err := Load("testdata/thing")
+	if err != nil {
+		t.Fatalf("loading package: %s", err)
+	}
+
+	if got, want := pkg.Name, "thing"; got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+
+	if got, want := pkg.Errors, []string{"AccessDeniedException", "ResourceNotFoundException"}; !slices.Equal(got, want) {
+		t.Errorf("Errors = %v, want %v", got, want)
+	}
+
+	if got, want := pkg.NotFoundError(), "ResourceNotFoundException"; got != want {
+		t.Errorf("NotFoundError() = %q, want %q", got, want)
+	}
+
+	if got, want := len(pkg.Enums["ThingStatus"]), 5; got != want {
+		t.Errorf("len(Enums[ThingStatus]) = %d, want %d", got, want)
+	}
+
+	if got, want := pkg.Enums["ThingStatus"][1], (EnumValue{Name: "ThingStatusActive", Value: "ACTIVE"}); got != want {
+		t.Errorf("Enums[ThingStatus][1] = %+v, want %+v", got, want)
+	}
+
+	if !pkg.Unions["Source"] {
+		t.Errorf("Source is not a union")
+	}
+
+	testCases := map[string]struct {
+		operation string
+		paginated bool
+		required  []string
+	}{
+		"create": {
+			operation: "CreateThing",
+			required:  []string{"Name", "RoleArn"},
+		},
+		"read": {
+			operation: "GetThing",
+			required:  []string{"ThingId"},
+		},
+		"list": {
+			operation: "ListThings",
+			paginated: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			op, err := pkg.Operation(testCase.operation)
+			if err != nil {
+				t.Fatalf("Operation(%q): %s", testCase.operation, err)
+			}
+
+			if got, want := op.Paginated, testCase.paginated; got != want {
+				t.Errorf("Paginated = %t, want %t", got, want)
+			}
+
+			var required []string
+			for _, f := range op.Input.RequiredFields() {
+				required = append(required, f.Name)
+			}
+
+			if got, want := required, testCase.required; !slices.Equal(got, want) {
+				t.Errorf("required = %v, want %v", got, want)
+			}
+
+			if op.Output.Field("ResultMetadata") != nil {
+				t.Errorf("ResultMetadata not skipped")
+			}
+		})
+	}
+
+	if _, err := pkg.Operation("DescribeThing"); err == nil {
+		t.Errorf("expected error for missing operation")
+	}
+}
+
+func TestLoadTypes(t *testing.T) {
+	t.Parallel()
+
+	pkg, err := Load("testdata/thing")
+	if err != nil {
+		t.Fatalf("loading package: %s", err)
+	}
+
+	testCases := map[string]struct {
+		structName string
+		field      string
+		kind       Kind
+		elem       Kind
+		typeName   string
+	}{
+		"string": {
+			structName: "Thing",
+			field:      "Arn",
+			kind:       KindString,
+		},
+		"time": {
+			structName: "Thing",
+			field:      "CreatedAt",
+			kind:       KindTime,
+		},
+		"enum": {
+			structName: "Thing",
+			field:      "Status",
+			kind:       KindEnum,
+			typeName:   "ThingStatus",
+		},
+		"struct": {
+			structName: "Thing",
+			field:      "Configuration",
+			kind:       KindStruct,
+			typeName:   "Configuration",
+		},
+		"union": {
+			structName: "Thing",
+			field:      "Source",
+			kind:       KindUnion,
+			typeName:   "Source",
+		},
+		"int32": {
+			structName: "Configuration",
+			field:      "Size",
+			kind:       KindInt32,
+		},
+		"list": {
+			structName: "Configuration",
+			field:      "SubnetIds",
+			kind:       KindList,
+			elem:       KindString,
+		},
+		"document": {
+			structName: "Configuration",
+			field:      "Settings",
+			kind:       KindDocument,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f := pkg.Structs[testCase.structName].Field(testCase.field)
+			if f == nil {
+				t.Fatalf("field %s.%s not found", testCase.structName, testCase.field)
+			}
+
+			if got, want := f.Type.Kind, testCase.kind; got != want {
+				t.Errorf("Kind = %d, want %d", got, want)
+			}
+
+			if got, want := f.Type.Name, testCase.typeName; got != want {
+				t.Errorf("Name = %q, want %q", got, want)
+			}
+
+			if testCase.elem != KindUnsupported {
+				if got, want := f.Type.Elem.Kind, testCase.elem; got != want {
+					t.Errorf("Elem.Kind = %d, want %d", got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by smithy-go-codegen DO NOT EDIT.
+
+package types
+
+import (
+	"github.com/aws/smithy-go/document"
+	"time"
+)
+
+// A thing's configuration.
+type Configuration struct {
+
+	// The thing's size.
+	//
+	// This member is required.
+	Size *int32
+
+	// Whether the thing is enabled.
+	Enabled *bool
+
+	// Subnets.
+	SubnetIds []string
+
+	// Arbitrary settings.
+	Settings document.Interface
+
+	noSmithyDocumentSerde
+}
+
+// A thing.
+type Thing struct {
+
+	// The thing's ARN.
+	Arn *string
+
+	// The thing's configuration.
+	Configuration *Configuration
+
+	// When the thing was created.
+	CreatedAt *time.Time
+
+	// A description.
+	Description *string
+
+	// The thing's name.
+	Name *string
+
+	// The ARN of the role the thing assumes.
+	RoleArn *string
+
+	// The thing's status.
+	Status ThingStatus
+
+	// Why the thing failed.
+	StatusReason *string
+
+	// The thing's ID.
+	ThingId *string
+
+	// The thing's source.
+	Source Source
+
+	noSmithyDocumentSerde
+}
+
+// A thing summary.
+type ThingSummary struct {
+
+	// The thing's ARN.
+	Arn *string
+
+	// The thing's name.
+	Name *string
+
+	// The thing's ID.
+	ThingId *string
+
+	// The thing's status.
+	Status ThingStatus
+
+	noSmithyDocumentSerde
+}
+
+// The thing's source.
+//
+// The following types satisfy this interface:
+//
+//	SourceMemberS3Uri
+type Source interface {
+	isSource()
+}
+
+// An S3 URI.
+type SourceMemberS3Uri struct {
+	Value string
+
+	noSmithyDocumentSerde
+}
+
+func (*SourceMemberS3Uri) isSource() {}
+
+type noSmithyDocumentSerde = document.NoSerde
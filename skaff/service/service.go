@@ -0,0 +1,439 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package service
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/YakDriver/regexache"
+)
+
+//go:embed generate.tmpl
+var generateTmpl string
+
+//go:embed sweep.tmpl
+var sweepTmpl string
+
+const (
+	serviceDataFile    = "names/data/names_data.csv"
+	sdkV2ModulePrefix  = "github.com/aws/aws-sdk-go-v2/service/"
+	requiredMemberText = "This member is required."
+)
+
+// Options describes the service to scaffold.
+type Options struct {
+	Name              string // Provider package name, e.g. "foo"
+	SDKID             string // AWS SDK service ID, e.g. "Foo"
+	HumanFriendly     string // Defaults to SDKID
+	Brand             string // "AWS" or "Amazon"
+	CLICommand        string // AWS CLI v2 command, defaults to the lower-cased SDKID with dashes for spaces
+	GoV2Package       string // AWS SDK for Go v2 package name, defaults to Name
+	ProviderNameUpper string // Defaults to SDKID without spaces
+	EndpointAPICall   string // Defaults to the first List operation without required parameters
+	IncludeComments   bool
+	Force             bool
+	Generate          bool // Whether to fetch the AWS SDK for Go v2 module and run the code generators
+}
+
+type TemplateData struct {
+	HumanFriendly   string
+	IncludeComments bool
+	ProviderPackage string
+	ResourcePrefix  string
+}
+
+// Create adds a service to the names data and scaffolds its service package.
+// If Generate is set, the service's AWS SDK for Go v2 module is added to the provider's go.mod and
+// all code generators that depend on the names data are run, producing a compiling package with generated endpoint tests.
+func Create(opts Options) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	root, err := findProviderRoot(wd)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.setDefaults(); err != nil {
+		return fmt.Errorf("error checking: %w", err)
+	}
+
+	dataFile := filepath.Join(root, filepath.FromSlash(serviceDataFile))
+	header, records, err := readServiceData(dataFile)
+	if err != nil {
+		return err
+	}
+
+	if i := slices.IndexFunc(records, func(r []string) bool { return recordHasPackage(header, r, opts.Name) }); i >= 0 {
+		return fmt.Errorf("error checking: service %q already exists in %s (line %d)", opts.Name, serviceDataFile, i+2)
+	}
+
+	serviceDir := filepath.Join(root, "internal", "service", opts.Name)
+	if _, err := os.Stat(serviceDir); !errors.Is(err, fs.ErrNotExist) && !opts.Force {
+		return fmt.Errorf("directory (%s) already exists and force is not set", serviceDir)
+	}
+
+	module := sdkV2ModulePrefix + opts.GoV2Package
+
+	// Add the AWS SDK for Go v2 module unless the provider already requires it.
+	if _, err := commandOutput(root, "go", "list", "-m", module); err != nil && opts.Generate {
+		if err := runCommand(root, "go", "get", module); err != nil {
+			return fmt.Errorf("adding AWS SDK for Go v2 module (%s): %w", module, err)
+		}
+	}
+
+	if opts.EndpointAPICall == "" {
+		out, err := commandOutput(root, "go", "list", "-m", "-f", "{{ .Dir }}", module)
+		if err != nil {
+			return fmt.Errorf("locating AWS SDK for Go v2 module (%s), set the endpoint API call explicitly: %w", module, err)
+		}
+
+		opts.EndpointAPICall, err = findEndpointAPICall(strings.TrimSpace(out))
+		if err != nil {
+			return fmt.Errorf("finding endpoint API call, set it explicitly: %w", err)
+		}
+	}
+
+	record, err := newServiceRecord(header, opts)
+	if err != nil {
+		return err
+	}
+
+	if err := appendServiceRecord(dataFile, record); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(serviceDir, 0755); err != nil {
+		return fmt.Errorf("error creating directory (%s): %s", serviceDir, err)
+	}
+
+	td := TemplateData{
+		HumanFriendly:   opts.HumanFriendly,
+		IncludeComments: opts.IncludeComments,
+		ProviderPackage: opts.Name,
+		ResourcePrefix:  fmt.Sprintf("aws_%s_", opts.Name),
+	}
+
+	if err := writeTemplate("generate", filepath.Join(serviceDir, "generate.go"), generateTmpl, opts.Force, td); err != nil {
+		return fmt.Errorf("writing generate template: %w", err)
+	}
+
+	if err := writeTemplate("sweep", filepath.Join(serviceDir, "sweep.go"), sweepTmpl, opts.Force, td); err != nil {
+		return fmt.Errorf("writing sweep template: %w", err)
+	}
+
+	if !opts.Generate {
+		return nil
+	}
+
+	// Generate the service package first and the service package lists last, as in `make gen`.
+	dirs := []string{
+		"./internal/service/" + opts.Name,
+		"./names",
+		"./internal/conns",
+		"./internal/generate/allowsubcats",
+		"./internal/generate/checknames",
+		"./internal/generate/customends",
+		"./internal/generate/issuelabels",
+		"./internal/generate/prlabels",
+		"./internal/generate/serviceendpointtests",
+		"./internal/generate/servicelabels",
+		"./internal/generate/servicesemgrep",
+		"./internal/generate/teamcity",
+		"./internal/provider",
+		"./internal/sweep",
+		"./internal/sweeper",
+	}
+
+	for _, dir := range dirs {
+		if err := runCommand(root, "go", "generate", dir); err != nil {
+			return fmt.Errorf("generating %s: %w", dir, err)
+		}
+	}
+
+	if err := runCommand(root, "go", "mod", "tidy"); err != nil {
+		return fmt.Errorf("tidying go.mod: %w", err)
+	}
+
+	return nil
+}
+
+func (o *Options) setDefaults() error {
+	if o.Name == "" {
+		return errors.New("no name given")
+	}
+
+	if !regexache.MustCompile(`^[a-z][0-9a-z]*$`).MatchString(o.Name) {
+		return fmt.Errorf("name (%s) should be all lower case letters and digits (e.g., costoptimizationhub)", o.Name)
+	}
+
+	if o.SDKID == "" {
+		return errors.New("no SDK ID given")
+	}
+
+	if o.HumanFriendly == "" {
+		o.HumanFriendly = o.SDKID
+	}
+
+	if o.Brand == "" {
+		o.Brand = "AWS"
+	}
+
+	if o.Brand != "AWS" && o.Brand != "Amazon" {
+		return fmt.Errorf("brand (%s) should be AWS or Amazon", o.Brand)
+	}
+
+	if o.CLICommand == "" {
+		o.CLICommand = strings.ToLower(strings.Join(strings.Fields(o.SDKID), "-"))
+	}
+
+	if o.GoV2Package == "" {
+		o.GoV2Package = o.Name
+	}
+
+	if o.ProviderNameUpper == "" {
+		o.ProviderNameUpper = strings.Join(strings.Fields(o.SDKID), "")
+	}
+
+	if o.ProviderNameUpper == strings.ToLower(o.ProviderNameUpper) {
+		return fmt.Errorf("provider name (%s) should be properly capitalized (e.g., CostOptimizationHub)", o.ProviderNameUpper)
+	}
+
+	return nil
+}
+
+// newServiceRecord returns the names data record for the service, with fields in the order of the names data header.
+func newServiceRecord(header []string, opts Options) ([]string, error) {
+	values := map[string]string{
+		"AWSCLIV2Command":         opts.CLICommand,
+		"AWSCLIV2CommandNoDashes": strings.ReplaceAll(opts.CLICommand, "-", ""),
+		"GoV2Package":             opts.GoV2Package,
+		"ProviderPackageCorrect":  opts.Name,
+		"ProviderNameUpper":       opts.ProviderNameUpper,
+		"ClientSDKV2":             "2",
+		"ResourcePrefixCorrect":   fmt.Sprintf("aws_%s_", opts.Name),
+		"DocPrefix":               opts.Name + "_",
+		"HumanFriendly":           opts.HumanFriendly,
+		"Brand":                   opts.Brand,
+		"SDKID":                   opts.SDKID,
+		"EndpointAPICall":         opts.EndpointAPICall,
+	}
+
+	record := make([]string, len(header))
+	for i, column := range header {
+		record[i] = values[column]
+		delete(values, column)
+	}
+
+	if len(values) > 0 {
+		var missing []string
+		for column := range values {
+			missing = append(missing, column)
+		}
+		slices.Sort(missing)
+
+		return nil, fmt.Errorf("%s is missing columns: %s", serviceDataFile, strings.Join(missing, ", "))
+	}
+
+	return record, nil
+}
+
+// recordHasPackage returns whether the names data record is for the specified provider package or alias.
+func recordHasPackage(header, record []string, name string) bool {
+	for i, column := range header {
+		if i >= len(record) {
+			break
+		}
+
+		switch column {
+		case "ProviderPackageActual", "ProviderPackageCorrect":
+			if record[i] == name {
+				return true
+			}
+		case "Aliases":
+			if slices.Contains(strings.Split(record[i], ";"), name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// findProviderRoot returns the root directory of the provider repository containing dir.
+func findProviderRoot(dir string) (string, error) {
+	for {
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(serviceDataFile))); err == nil {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("error checking: %s not found, run from within the provider repository", serviceDataFile)
+		}
+		dir = parent
+	}
+}
+
+func readServiceData(filename string) ([]string, [][]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("error reading file (%s): no header", filename)
+	}
+
+	return records[0], records[1:], nil
+}
+
+// appendServiceRecord appends the record to the names data file.
+// New services are added to the end of the file.
+func appendServiceRecord(filename string, record []string) error {
+	body, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	var buffer bytes.Buffer
+	buffer.Write(body)
+	if len(body) > 0 && body[len(body)-1] != '\n' {
+		buffer.WriteByte('\n')
+	}
+
+	w := csv.NewWriter(&buffer)
+	if err := w.Write(record); err != nil {
+		return fmt.Errorf("error writing record: %s", err)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("error writing record: %s", err)
+	}
+
+	if err := os.WriteFile(filename, buffer.Bytes(), 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+// findEndpointAPICall returns the name of the alphabetically first List operation with no required parameters
+// in the AWS SDK for Go v2 service package source in dir.
+func findEndpointAPICall(dir string) (string, error) {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join(dir, "api_op_List*.go"))
+	if err != nil {
+		return "", err
+	}
+
+	slices.Sort(files)
+
+	for _, filename := range files {
+		operation := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(filename), "api_op_"), ".go")
+
+		f, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+		if err != nil {
+			return "", fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		if input := findStruct(f, operation+"Input"); input != nil && !hasRequiredMember(input) {
+			return operation, nil
+		}
+	}
+
+	return "", fmt.Errorf("no List operation without required parameters found in %s", dir)
+}
+
+func findStruct(f *ast.File, name string) *ast.StructType {
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range decl.Specs {
+			if spec, ok := spec.(*ast.TypeSpec); ok && spec.Name.Name == name {
+				if v, ok := spec.Type.(*ast.StructType); ok {
+					return v
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func hasRequiredMember(v *ast.StructType) bool {
+	for _, field := range v.Fields.List {
+		if field.Doc != nil && strings.Contains(field.Doc.Text(), requiredMemberText) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func runCommand(dir, name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
+
+func commandOutput(dir, name string, args ...string) (string, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	cmd.Stderr = os.Stderr
+
+	out, err := cmd.Output()
+
+	return string(out), err
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.Execute(&buffer, td); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	if err := os.WriteFile(filename, buffer.Bytes(), 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
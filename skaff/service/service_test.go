@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetDefaults(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts        Options
+		expected    Options
+		expectError bool
+	}{
+		"no name": {
+			opts:        Options{SDKID: "Foo"},
+			expectError: true,
+		},
+		"invalid name": {
+			opts:        Options{Name: "foo_bar", SDKID: "Foo Bar"},
+			expectError: true,
+		},
+		"no SDK ID": {
+			opts:        Options{Name: "foo"},
+			expectError: true,
+		},
+		"invalid brand": {
+			opts:        Options{Name: "foo", SDKID: "Foo", Brand: "Acme"},
+			expectError: true,
+		},
+		"defaults": {
+			opts: Options{Name: "costoptimizationhub", SDKID: "Cost Optimization Hub"},
+			expected: Options{
+				Name:              "costoptimizationhub",
+				SDKID:             "Cost Optimization Hub",
+				HumanFriendly:     "Cost Optimization Hub",
+				Brand:             "AWS",
+				CLICommand:        "cost-optimization-hub",
+				GoV2Package:       "costoptimizationhub",
+				ProviderNameUpper: "CostOptimizationHub",
+			},
+		},
+		"overrides": {
+			opts: Options{Name: "foo", SDKID: "Foo", HumanFriendly: "Foo Service", Brand: "Amazon", ProviderNameUpper: "FOO"},
+			expected: Options{
+				Name:              "foo",
+				SDKID:             "Foo",
+				HumanFriendly:     "Foo Service",
+				Brand:             "Amazon",
+				CLICommand:        "foo",
+				GoV2Package:       "foo",
+				ProviderNameUpper: "FOO",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := testCase.opts
+			err := opts.setDefaults()
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("err = %v, expectError = %t", err, want)
+			}
+
+			if err == nil && opts != testCase.expected {
+				t.Errorf("opts = %+v, want %+v", opts, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestNewServiceRecord(t *testing.T) {
+	t.Parallel()
+
+	header, records, err := readServiceData(filepath.Join("..", "..", filepath.FromSlash(serviceDataFile)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := Options{
+		Name:            "costoptimizationhub",
+		SDKID:           "Cost Optimization Hub",
+		EndpointAPICall: "ListRecommendationSummaries",
+	}
+
+	if err := opts.setDefaults(); err != nil {
+		t.Fatal(err)
+	}
+
+	record, err := newServiceRecord(header, opts)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "cost-optimization-hub,costoptimizationhub,,costoptimizationhub,,costoptimizationhub,,,CostOptimizationHub,,,,2,,aws_costoptimizationhub_,,costoptimizationhub_,Cost Optimization Hub,AWS,,,,,,,Cost Optimization Hub,ListRecommendationSummaries,,"
+
+	if got := strings.Join(record, ","); got != want {
+		t.Errorf("record = %s, want %s", got, want)
+	}
+
+	for _, v := range []string{"costoptimizationhub", "prometheusservice"} {
+		if !recordHasPackageInRecords(header, records, v) {
+			t.Errorf("service %s not found", v)
+		}
+	}
+
+	if recordHasPackageInRecords(header, records, "cost-optimization-hub") {
+		t.Error("unexpected service cost-optimization-hub found")
+	}
+}
+
+func recordHasPackageInRecords(header []string, records [][]string, name string) bool {
+	for _, record := range records {
+		if recordHasPackage(header, record, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestAppendServiceRecord(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "names_data.csv")
+
+	if err := os.WriteFile(filename, []byte("A,B,C\na,b,c"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := appendServiceRecord(filename, []string{"x", "y z", ""}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile(filename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "A,B,C\na,b,c\nx,y z,\n"; string(got) != want {
+		t.Errorf("file = %q, want %q", got, want)
+	}
+}
+
+func TestFindEndpointAPICall(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for filename, body := range map[string]string{
+		"api_op_CreateThing.go": `package foo
+type CreateThingInput struct {
+	Name *string
+}
+`,
+		"api_op_ListAttachments.go": `package foo
+type ListAttachmentsInput struct {
+	// The thing's name.
+	//
+	// This member is required.
+	ThingName *string
+
+	MaxResults *int32
+}
+`,
+		"api_op_ListThings.go": `package foo
+type ListThingsInput struct {
+	// The maximum number of results.
+	MaxResults *int32
+
+	NextToken *string
+}
+`,
+		"api_op_ListWidgets.go": `package foo
+type ListWidgetsInput struct{}
+`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, filename), []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := findEndpointAPICall(dir)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "ListThings"; got != want {
+		t.Errorf("endpoint API call = %s, want %s", got, want)
+	}
+
+	if _, err := findEndpointAPICall(t.TempDir()); err == nil {
+		t.Error("expected error")
+	}
+}
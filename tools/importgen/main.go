@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var (
+	input  = flag.String("input", "", "file containing the ARNs to import, one per line (default standard input)")
+	output = flag.String("output", "", "file to write the import blocks to (default standard output)")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\timportgen [-input <file>] [-output <file>]\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if err := run(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	mappings, err := loadMappings(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	var r io.Reader = os.Stdin
+	if *input != "" {
+		f, err := os.Open(*input)
+
+		if err != nil {
+			return fmt.Errorf("opening input (%s): %w", *input, err)
+		}
+
+		defer f.Close()
+
+		r = f
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+
+		if err != nil {
+			return fmt.Errorf("creating output (%s): %w", *output, err)
+		}
+
+		defer f.Close()
+
+		w = f
+	}
+
+	return generate(r, w, os.Stderr, mappings)
+}
+
+// loadMappings returns the ARN to import ID mappings of all the provider's resources that declare them.
+func loadMappings(ctx context.Context) ([]*mapping, error) {
+	var mappings []*mapping
+
+	for _, sp := range provider.ServicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			resourceImport := resourceImport(v.Import, v.Identity)
+
+			if resourceImport == nil {
+				continue
+			}
+
+			m, err := newMapping(v.TypeName, resourceImport)
+
+			if err != nil {
+				return nil, err
+			}
+
+			mappings = append(mappings, m)
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			resourceImport := resourceImport(v.Import, v.Identity)
+
+			if resourceImport == nil {
+				continue
+			}
+
+			r, err := v.Factory(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			response := resource.MetadataResponse{}
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+			m, err := newMapping(response.TypeName, resourceImport)
+
+			if err != nil {
+				return nil, err
+			}
+
+			mappings = append(mappings, m)
+		}
+	}
+
+	return mappings, nil
+}
+
+// resourceImport returns a resource's import information, falling back to that implied by its identity.
+func resourceImport(v *types.ServicePackageResourceImport, identity *types.ServicePackageResourceIdentity) *types.ServicePackageResourceImport {
+	if v == nil && identity != nil {
+		v = importFromIdentity(identity)
+	}
+
+	return v
+}
+
+// generate reads ARNs from r and writes an import block for each to w.
+// ARNs that can't be mapped to a resource type are reported to warnings.
+func generate(r io.Reader, w, warnings io.Writer, mappings []*mapping) error {
+	var addresses []string
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		v, err := arn.Parse(line)
+
+		if err != nil {
+			fmt.Fprintf(warnings, "skipping: %s\n", err)
+			continue
+		}
+
+		typeName, id, err := match(mappings, v)
+
+		if err != nil {
+			fmt.Fprintf(warnings, "skipping: %s\n", err)
+			continue
+		}
+
+		address := typeName + "." + resourceName(id)
+		for i := 2; slices.Contains(addresses, address); i++ {
+			address = fmt.Sprintf("%s.%s_%d", typeName, resourceName(id), i)
+		}
+		addresses = append(addresses, address)
+
+		if _, err := fmt.Fprintf(w, "import {\n  to = %s\n  id = %s\n}\n\n", address, quote(id)); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// resourceName returns a valid Terraform resource name derived from the specified import ID.
+func resourceName(id string) string {
+	// Use the last part of ARNs and paths.
+	if i := strings.LastIndexAny(id, ":/"); i >= 0 && i < len(id)-1 {
+		id = id[i+1:]
+	}
+
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return '_'
+		}
+	}, id)
+
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+
+	return name
+}
+
+// quote returns the specified string as an HCL string literal.
+func quote(s string) string {
+	s = strconv.Quote(s)
+	s = strings.ReplaceAll(s, "${", "$${")
+	s = strings.ReplaceAll(s, "%{", "%%{")
+
+	return s
+}
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var placeholder = regexp.MustCompile(`\{([0-9A-Za-z_]+)\}`)
+
+// ARN parts that can be referenced in an import ID format in addition to the parts named in the ARN resource pattern.
+var arnParts = []string{"arn", "partition", "region", "account"}
+
+// mapping maps the ARNs of a single resource type to import IDs.
+type mapping struct {
+	typeName    string
+	service     string
+	pattern     *regexp.Regexp
+	id          string
+	specificity int // The number of literal characters in the ARN resource pattern
+}
+
+// newMapping returns a mapping for the specified resource type from its import information.
+//
+// The ARN resource pattern is matched against the whole of the ARN's resource part.
+// `{name}` matches a single path segment, i.e. one or more characters other than `/` and `:`, and captures it as `name`.
+// `*` matches any characters.
+// The import ID format may reference the captured parts and the ARN's `arn`, `partition`, `region` and `account`.
+func newMapping(typeName string, v *types.ServicePackageResourceImport) (*mapping, error) {
+	m := &mapping{
+		typeName: typeName,
+		service:  v.ARNService,
+		id:       v.ID,
+	}
+
+	if m.service == "" {
+		return nil, fmt.Errorf("%s: ARN service is required", typeName)
+	}
+
+	if m.id == "" {
+		m.id = "{arn}"
+	}
+
+	var expr strings.Builder
+	names := make(map[string]struct{})
+	for _, v := range arnParts {
+		names[v] = struct{}{}
+	}
+
+	expr.WriteString(`^`)
+	for s := v.ARNResource; s != ""; {
+		if loc := placeholder.FindStringSubmatchIndex(s); loc != nil && loc[0] == 0 {
+			name := s[loc[2]:loc[3]]
+
+			if _, ok := names[name]; ok {
+				return nil, fmt.Errorf("%s: duplicate or reserved ARN resource pattern part (%s)", typeName, name)
+			}
+			names[name] = struct{}{}
+
+			expr.WriteString(`(?P<` + name + `>[^/:]+)`)
+			s = s[loc[1]:]
+			continue
+		}
+
+		if s[0] == '*' {
+			expr.WriteString(`.*?`)
+		} else {
+			expr.WriteString(regexp.QuoteMeta(s[:1]))
+			m.specificity++
+		}
+		s = s[1:]
+	}
+	expr.WriteString(`$`)
+
+	pattern, err := regexp.Compile(expr.String())
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid ARN resource pattern (%s): %w", typeName, v.ARNResource, err)
+	}
+
+	m.pattern = pattern
+
+	for _, v := range placeholder.FindAllStringSubmatch(m.id, -1) {
+		if _, ok := names[v[1]]; !ok {
+			return nil, fmt.Errorf("%s: unknown import ID part (%s)", typeName, v[1])
+		}
+	}
+
+	return m, nil
+}
+
+// importFromIdentity returns the import information implied by the specified resource identity,
+// or nil if the identity has no ARN format or the ARN doesn't contain all of the resource's ID attributes.
+func importFromIdentity(v *types.ServicePackageResourceIdentity) *types.ServicePackageResourceImport {
+	// arn:{partition}:service:{region}:{account}:resource
+	parts := strings.SplitN(v.ARNFormat, ":", 6)
+
+	if len(parts) != 6 {
+		return nil
+	}
+
+	ids := make([]string, len(v.IDAttributes))
+	for i, attr := range v.IDAttributes {
+		id := "{" + attr + "}"
+
+		if !strings.Contains(parts[5], id) {
+			return nil
+		}
+
+		ids[i] = id
+	}
+
+	return &types.ServicePackageResourceImport{
+		ARNService:  parts[2],
+		ARNResource: parts[5],
+		ID:          strings.Join(ids, v.IDSeparator),
+	}
+}
+
+// importID returns the import ID for the specified ARN, or false if the ARN doesn't match.
+func (m *mapping) importID(v arn.ARN) (string, bool) {
+	if v.Service != m.service {
+		return "", false
+	}
+
+	match := m.pattern.FindStringSubmatch(v.Resource)
+
+	if match == nil {
+		return "", false
+	}
+
+	parts := map[string]string{
+		"arn":       v.String(),
+		"partition": v.Partition,
+		"region":    v.Region,
+		"account":   v.AccountID,
+	}
+	for i, name := range m.pattern.SubexpNames() {
+		if name != "" {
+			parts[name] = match[i]
+		}
+	}
+
+	return placeholder.ReplaceAllStringFunc(m.id, func(s string) string {
+		return parts[s[1:len(s)-1]]
+	}), true
+}
+
+// match returns the resource type and import ID for the specified ARN.
+// If more than one mapping matches, the one with the most specific ARN resource pattern is used.
+// An error is returned if the ARN matches no mapping, or more than one equally specific mapping.
+func match(mappings []*mapping, v arn.ARN) (string, string, error) {
+	var matched []*mapping
+	var ids []string
+
+	for _, m := range mappings {
+		id, ok := m.importID(v)
+
+		if !ok {
+			continue
+		}
+
+		if len(matched) > 0 && m.specificity < matched[0].specificity {
+			continue
+		}
+
+		if len(matched) > 0 && m.specificity > matched[0].specificity {
+			matched, ids = nil, nil
+		}
+
+		matched = append(matched, m)
+		ids = append(ids, id)
+	}
+
+	switch len(matched) {
+	case 0:
+		return "", "", fmt.Errorf("no resource type matches ARN (%s)", v)
+	case 1:
+		return matched[0].typeName, ids[0], nil
+	default:
+		var typeNames []string
+		for _, m := range matched {
+			typeNames = append(typeNames, m.typeName)
+		}
+
+		return "", "", fmt.Errorf("multiple resource types (%s) match ARN (%s)", strings.Join(typeNames, ", "), v)
+	}
+}
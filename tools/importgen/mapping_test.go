@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+func testMappings(t *testing.T) []*mapping {
+	t.Helper()
+
+	var mappings []*mapping
+
+	for typeName, v := range map[string]*types.ServicePackageResourceImport{
+		"aws_iam_role":        {ARNService: "iam", ARNResource: "role/*{name}", ID: "{name}"},
+		"aws_iam_policy":      {ARNService: "iam", ARNResource: "policy/*{name}"},
+		"aws_lambda_function": {ARNService: "lambda", ARNResource: "function:{name}", ID: "{name}"},
+		"aws_s3_bucket":       {ARNService: "s3", ARNResource: "{bucket}", ID: "{bucket}"},
+		"aws_vpc":             {ARNService: "ec2", ARNResource: "vpc/{id}", ID: "{id}"},
+		"aws_vpc_endpoint":    {ARNService: "ec2", ARNResource: "vpc-endpoint/{id}", ID: "{id}"},
+		"aws_ec2_resource":    {ARNService: "ec2", ARNResource: "*{id}", ID: "{region}/{account}/{id}"},
+		"aws_ec2_other":       {ARNService: "ec2", ARNResource: "other/{id}", ID: "{id}"},
+		"aws_ec2_other_too":   {ARNService: "ec2", ARNResource: "other/{id}", ID: "{id}"},
+		"aws_dynamodb_table":  {ARNService: "dynamodb", ARNResource: "table/{name}", ID: "{name}"},
+		"aws_sns_topic":       {ARNService: "sns", ARNResource: "{name}"},
+	} {
+		m, err := newMapping(typeName, v)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mappings = append(mappings, m)
+	}
+
+	return mappings
+}
+
+func TestNewMappingInvalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]*types.ServicePackageResourceImport{
+		"no service":     {ARNResource: "role/{name}"},
+		"unknown part":   {ARNService: "iam", ARNResource: "role/{name}", ID: "{id}"},
+		"duplicate part": {ARNService: "iam", ARNResource: "role/{name}/{name}"},
+		"reserved part":  {ARNService: "iam", ARNResource: "role/{region}"},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := newMapping("aws_test", testCase); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestImportFromIdentity(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identity *types.ServicePackageResourceIdentity
+		expected *types.ServicePackageResourceImport
+	}{
+		"no ARN format": {
+			identity: &types.ServicePackageResourceIdentity{
+				IDAttributes: []string{"function_name", "qualifier"},
+				IDSeparator:  ",",
+			},
+		},
+		"single part": {
+			identity: &types.ServicePackageResourceIdentity{
+				IDAttributes: []string{"name"},
+				ARNFormat:    "arn:{partition}:ecr:{region}:{account}:repository/{name}",
+			},
+			expected: &types.ServicePackageResourceImport{ARNService: "ecr", ARNResource: "repository/{name}", ID: "{name}"},
+		},
+		"multiple parts": {
+			identity: &types.ServicePackageResourceIdentity{
+				IDAttributes: []string{"function_name", "qualifier"},
+				IDSeparator:  ",",
+				ARNFormat:    "arn:{partition}:lambda:{region}:{account}:function:{function_name}:{qualifier}",
+			},
+			expected: &types.ServicePackageResourceImport{ARNService: "lambda", ARNResource: "function:{function_name}:{qualifier}", ID: "{function_name},{qualifier}"},
+		},
+		"ID attribute not in ARN": {
+			identity: &types.ServicePackageResourceIdentity{
+				IDAttributes: []string{"table_name", "stream_arn"},
+				IDSeparator:  ",",
+				ARNFormat:    "arn:{partition}:dynamodb:{region}:{account}:table/{table_name}",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := importFromIdentity(testCase.identity)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	mappings := testMappings(t)
+
+	testCases := map[string]struct {
+		arn              string
+		expectedTypeName string
+		expectedID       string
+		expectError      bool
+	}{
+		"IAM role": {
+			arn:              "arn:aws:iam::123456789012:role/my-role",
+			expectedTypeName: "aws_iam_role",
+			expectedID:       "my-role",
+		},
+		"IAM role with path": {
+			arn:              "arn:aws:iam::123456789012:role/service-role/team/my-role",
+			expectedTypeName: "aws_iam_role",
+			expectedID:       "my-role",
+		},
+		"IAM policy": {
+			arn:              "arn:aws:iam::123456789012:policy/my-policy",
+			expectedTypeName: "aws_iam_policy",
+			expectedID:       "arn:aws:iam::123456789012:policy/my-policy",
+		},
+		"Lambda function": {
+			arn:              "arn:aws:lambda:us-west-2:123456789012:function:my-function",
+			expectedTypeName: "aws_lambda_function",
+			expectedID:       "my-function",
+		},
+		"Lambda function version": {
+			arn:         "arn:aws:lambda:us-west-2:123456789012:function:my-function:1",
+			expectError: true,
+		},
+		"S3 bucket": {
+			arn:              "arn:aws:s3:::my-bucket",
+			expectedTypeName: "aws_s3_bucket",
+			expectedID:       "my-bucket",
+		},
+		"S3 object": {
+			arn:         "arn:aws:s3:::my-bucket/key",
+			expectError: true,
+		},
+		"VPC most specific": {
+			arn:              "arn:aws-us-gov:ec2:us-gov-west-1:123456789012:vpc/vpc-12345678",
+			expectedTypeName: "aws_vpc",
+			expectedID:       "vpc-12345678",
+		},
+		"EC2 fallback": {
+			arn:              "arn:aws:ec2:us-west-2:123456789012:image/ami-12345678",
+			expectedTypeName: "aws_ec2_resource",
+			expectedID:       "us-west-2/123456789012/ami-12345678",
+		},
+		"ambiguous": {
+			arn:         "arn:aws:ec2:us-west-2:123456789012:other/o-12345678",
+			expectError: true,
+		},
+		"DynamoDB table stream": {
+			arn:         "arn:aws:dynamodb:us-west-2:123456789012:table/my-table/stream/2024-01-01T00:00:00.000",
+			expectError: true,
+		},
+		"unknown service": {
+			arn:         "arn:aws:sqs:us-west-2:123456789012:my-queue",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := arn.Parse(testCase.arn)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			typeName, id, err := match(mappings, v)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("err = %v, expectError = %t", err, want)
+			}
+
+			if got, want := typeName, testCase.expectedTypeName; got != want {
+				t.Errorf("typeName = %q, want %q", got, want)
+			}
+
+			if got, want := id, testCase.expectedID; got != want {
+				t.Errorf("id = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	input := strings.Join([]string{
+		"# Discovered resources",
+		"arn:aws:iam::123456789012:role/my-role",
+		"arn:aws:iam::123456789012:role/other/my-role",
+		"",
+		"arn:aws:sqs:us-west-2:123456789012:my-queue",
+		"not-an-arn",
+		"arn:aws:sns:us-west-2:123456789012:1-topic",
+	}, "\n")
+
+	var output, warnings bytes.Buffer
+
+	if err := generate(strings.NewReader(input), &output, &warnings, testMappings(t)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `import {
+  to = aws_iam_role.my_role
+  id = "my-role"
+}
+
+import {
+  to = aws_iam_role.my_role_2
+  id = "my-role"
+}
+
+import {
+  to = aws_sns_topic.r_1_topic
+  id = "arn:aws:sns:us-west-2:123456789012:1-topic"
+}
+
+`
+	if got := output.String(); got != want {
+		t.Errorf("output = %s, want %s", got, want)
+	}
+
+	if got, want := strings.Count(warnings.String(), "skipping"), 2; got != want {
+		t.Errorf("warnings = %s, want %d", warnings.String(), want)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	t.Parallel()
+
+	if got, want := quote(`a"${b}%{c}`), `"a\"$${b}%%{c}"`; got != want {
+		t.Errorf("quote = %s, want %s", got, want)
+	}
+}
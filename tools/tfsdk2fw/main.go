@@ -13,10 +13,12 @@ import (
 	"path"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
@@ -139,6 +141,13 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		StructWriter: &sbStruct,
 	}
 
+	modelName := naming.ToLowerCamelCase(m.Name)
+	if m.IsDataSource {
+		modelName += "DataSourceModel"
+	} else {
+		modelName += "ResourceModel"
+	}
+
 	err := emitter.emitSchemaForResource(m.Resource)
 
 	if err != nil {
@@ -146,23 +155,41 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	}
 
 	templateData := &templateData{
-		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
-		DefaultReadTimeout:           emitter.DefaultReadTimeout,
-		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
-		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
+		AWSPackage:                   m.PackageName,
+		DefaultCreateTimeout:         durationExpr(emitter.DefaultCreateTimeout),
+		DefaultReadTimeout:           durationExpr(emitter.DefaultReadTimeout),
+		DefaultUpdateTimeout:         durationExpr(emitter.DefaultUpdateTimeout),
+		DefaultDeleteTimeout:         durationExpr(emitter.DefaultDeleteTimeout),
 		EmitResourceImportState:      m.Resource.Importer != nil,
 		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
 		HasTimeouts:                  emitter.HasTimeouts,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		ModelName:                    modelName,
+		Models:                       emitter.Models,
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
 		Schema:                       sbSchema.String(),
+		ServiceClient:                "TODOClient",
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	// The AWS SDK for Go v2 package and service client for the provider package, if known.
+	if v, err := names.AWSGoV2Package(m.PackageName); err == nil && v != "" {
+		templateData.AWSPackage = v
+	}
+	if v, err := names.ProviderNameUpper(m.PackageName); err == nil && v != "" {
+		templateData.ServiceClient = v + "Client"
+	}
+
+	// Plugin Framework state upgraders upgrade directly from the prior schema version to the current schema version.
+	for _, v := range m.Resource.StateUpgraders {
+		templateData.StateUpgraderVersions = append(templateData.StateUpgraderVersions, v.Version)
+	}
+	slices.Sort(templateData.StateUpgraderVersions)
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -187,10 +214,10 @@ func (m *migrator) infof(format string, a ...interface{}) {
 }
 
 type emitter struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	DefaultCreateTimeout          time.Duration
+	DefaultReadTimeout            time.Duration
+	DefaultUpdateTimeout          time.Duration
+	DefaultDeleteTimeout          time.Duration
 	Generator                     *common.Generator
 	FrameworkPlanModifierPackages []string // Package names for any terraform-plugin-framework plan modifiers. May contain duplicates.
 	FrameworkValidatorsPackages   []string // Package names for any terraform-plugin-framework-validators validators. May contain duplicates.
@@ -200,9 +227,10 @@ type emitter struct {
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	Models                        []string // Struct type declarations for nested block models, in the order in which the blocks are emitted.
 	ProviderPlanModifierPackages  []string // Package names for any provider plan modifiers. May contain duplicates.
 	SchemaWriter                  io.Writer
-	StructWriter                  io.Writer
+	StructWriter                  io.Writer // Writer for the fields of the model struct of the resource or nested block currently being emitted.
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -221,16 +249,16 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		e.HasTimeouts = true
 
 		if v := v.Create; v != nil {
-			e.DefaultCreateTimeout = int64(*v)
+			e.DefaultCreateTimeout = *v
 		}
 		if v := v.Read; v != nil {
-			e.DefaultReadTimeout = int64(*v)
+			e.DefaultReadTimeout = *v
 		}
 		if v := v.Update; v != nil {
-			e.DefaultUpdateTimeout = int64(*v)
+			e.DefaultUpdateTimeout = *v
 		}
 		if v := v.Delete; v != nil {
-			e.DefaultDeleteTimeout = int64(*v)
+			e.DefaultDeleteTimeout = *v
 		}
 	}
 
@@ -263,8 +291,6 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 // and emits the generated code to the emitter's Writer.
 // Property names are sorted prior to code generation to reduce diffs.
 func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
-	isTopLevelAttribute := len(path) == 0
-
 	// At this point we are emitting code for a schema.Block or Schema.
 	names := make([]string, 0)
 	for name := range schema {
@@ -286,10 +312,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitAttributeProperty(append(path, name), property)
 
@@ -297,9 +320,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -321,6 +342,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitBlockProperty(append(path, name), property)
 
@@ -328,6 +350,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -354,9 +377,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
@@ -364,9 +385,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
@@ -374,9 +393,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -389,9 +406,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			if isTopLevelAttribute && attributeName == "id" {
 				fprintf(e.SchemaWriter, "// TODO framework.IDAttribute()\n")
@@ -399,9 +414,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -418,9 +431,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fprintf(e.StructWriter, "types.List")
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -431,9 +442,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fprintf(e.StructWriter, "types.Map")
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -444,9 +453,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fprintf(e.StructWriter, "types.Set")
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -614,10 +621,15 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			modelName := nestedBlockModelName(path)
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.StructWriter, "fwtypes.ListNestedObjectValueOf[%s]", modelName)
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedBlockObject(path, modelName, v.Schema)
 
 			if err != nil {
 				return err
@@ -637,10 +649,15 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			modelName := nestedBlockModelName(path)
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.StructWriter, "fwtypes.SetNestedObjectValueOf[%s]", modelName)
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedBlockObject(path, modelName, v.Schema)
 
 			if err != nil {
 				return err
@@ -712,6 +729,31 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
+// emitNestedBlockObject generates the Plugin Framework code for a Plugin SDK Block's nested object
+// and emits the generated code to the emitter's Writer and the nested object's model struct to the emitter's Models.
+func (e *emitter) emitNestedBlockObject(path []string, modelName string, schema map[string]*schema.Schema) error {
+	sbStruct := strings.Builder{}
+	structWriter := e.StructWriter
+	e.StructWriter = &sbStruct
+	defer func() {
+		e.StructWriter = structWriter
+	}()
+
+	// Reserve the model's position so that a block's model precedes the models of any blocks nested within it.
+	i := len(e.Models)
+	e.Models = append(e.Models, "")
+
+	err := e.emitAttributesAndBlocks(path, schema)
+
+	if err != nil {
+		return err
+	}
+
+	e.Models[i] = fmt.Sprintf("type %s struct {\n%s}", modelName, sbStruct.String())
+
+	return nil
+}
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -878,15 +920,45 @@ func isAttribute(property *schema.Schema) bool {
 	return false
 }
 
+// nestedBlockModelName returns the name of the model struct for the nested block at the specified path.
+func nestedBlockModelName(path []string) string {
+	return naming.ToLowerCamelCase(strings.Join(path, "_")) + "Model"
+}
+
+// durationExpr returns a Go expression for the specified duration in the largest whole unit, e.g. "10 * time.Minute".
+// An empty string is returned for a zero duration.
+func durationExpr(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+
+	for _, v := range []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+	} {
+		if d%v.unit == 0 {
+			return fmt.Sprintf("%d * %s", d/v.unit, v.name)
+		}
+	}
+
+	return fmt.Sprintf("%d * time.Nanosecond", d)
+}
+
 func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
 type templateData struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	AWSPackage                    string // e.g. ec2
+	DefaultCreateTimeout          string // e.g. 10 * time.Minute
+	DefaultReadTimeout            string
+	DefaultUpdateTimeout          string
+	DefaultDeleteTimeout          string
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
 	EmitResourceUpdateSkeleton    bool
@@ -895,10 +967,14 @@ type templateData struct {
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
-	Name                          string // e.g. Instance
-	PackageName                   string // e.g. ec2
+	ModelName                     string   // e.g. instanceResourceModel
+	Models                        []string // Nested block model struct declarations
+	Name                          string   // e.g. Instance
+	PackageName                   string   // e.g. ec2
 	ProviderPlanModifierPackages  []string
 	Schema                        string
+	ServiceClient                 string // e.g. EC2Client
+	StateUpgraderVersions         []int
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
 }
@@ -45,6 +45,22 @@ func ToCamelCase(s string) string {
 	return s
 }
 
+// ToLowerCamelCase converts a string to lowerCamelCase.
+func ToLowerCamelCase(s string) string {
+	b := []byte(ToCamelCase(s))
+
+	// Lower-case any leading initialism, e.g. "VPCEndpoint" -> "vpcEndpoint".
+	for i := 0; i < len(b) && isCapitalLetter(b[i]); i++ {
+		if i > 0 && i+1 < len(b) && isLowercaseLetter(b[i+1]) {
+			break
+		}
+
+		b[i] = toLowercaseLetter(b[i])
+	}
+
+	return string(b)
+}
+
 func isCapitalLetter(ch byte) bool {
 	return ch >= 'A' && ch <= 'Z'
 }
@@ -62,3 +78,9 @@ func toCapitalLetter(ch byte) byte {
 	ch -= 'a'
 	return ch
 }
+
+func toLowercaseLetter(ch byte) byte {
+	ch += 'a'
+	ch -= 'A'
+	return ch
+}